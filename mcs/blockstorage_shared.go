@@ -0,0 +1,179 @@
+package mcs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/snapshots"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	blockStorageCreateTimeout = 30
+	blockStorageUpdateTimeout = 30
+	blockStorageDeleteTimeout = 30
+	blockStorageDelay         = 5
+	blockStorageMinTimeout    = 3
+)
+
+const (
+	blockStorageStatusCreating  = "creating"
+	blockStorageStatusAvailable = "available"
+	blockStorageStatusInUse     = "in-use"
+	blockStorageStatusAttaching = "attaching"
+	blockStorageStatusDetaching = "detaching"
+	blockStorageStatusExtending = "extending"
+	blockStorageStatusDeleting  = "deleting"
+	blockStorageStatusDeleted   = "deleted"
+)
+
+// blockStorageErrorStatuses are statuses volumes and snapshots never leave without manual intervention.
+var blockStorageErrorStatuses = []string{"error", "error_deleting", "error_extending", "error_restoring"}
+
+func blockStorageCheckErrorStatus(status string) error {
+	for _, s := range blockStorageErrorStatuses {
+		if status == s {
+			return fmt.Errorf("status is %s", status)
+		}
+	}
+	return nil
+}
+
+func blockStorageVolumeStateRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := volumes.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return v, blockStorageStatusDeleted, nil
+			}
+			return nil, "", err
+		}
+
+		if err := blockStorageCheckErrorStatus(v.Status); err != nil {
+			return v, v.Status, fmt.Errorf("there was an error with the volume: %s", err)
+		}
+
+		return v, v.Status, nil
+	}
+}
+
+func blockStorageSnapshotStateRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		s, err := snapshots.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return s, blockStorageStatusDeleted, nil
+			}
+			return nil, "", err
+		}
+
+		if err := blockStorageCheckErrorStatus(s.Status); err != nil {
+			return s, s.Status, fmt.Errorf("there was an error with the snapshot: %s", err)
+		}
+
+		return s, s.Status, nil
+	}
+}
+
+// waitForBlockStorage waits for the volume or snapshot to leave pending statuses.
+func waitForBlockStorage(refresh resource.StateRefreshFunc, pending, target []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    refresh,
+		Timeout:    timeout,
+		Delay:      blockStorageDelay * time.Second,
+		MinTimeout: blockStorageMinTimeout * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func blockStorageVolumeTypes(client *gophercloud.ServiceClient) ([]volumetypes.VolumeType, error) {
+	allPages, err := volumetypes.List(client, volumetypes.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return volumetypes.ExtractVolumeTypes(allPages)
+}
+
+// checkBlockStorageVolumeTypesExist ensures that volume types exist in the region of the block storage client.
+// Volume types may be referenced by names or IDs.
+func checkBlockStorageVolumeTypesExist(client *gophercloud.ServiceClient, names []string, region string) error {
+	allVolumeTypes, err := blockStorageVolumeTypes(client)
+	if err != nil {
+		return fmt.Errorf("error retrieving volume types: %s", err)
+	}
+
+	known := make(map[string]bool, 2*len(allVolumeTypes))
+	available := make([]string, 0, len(allVolumeTypes))
+	for _, vt := range allVolumeTypes {
+		known[vt.ID] = true
+		known[vt.Name] = true
+		available = append(available, vt.Name)
+	}
+	sort.Strings(available)
+
+	for _, name := range names {
+		if !known[name] {
+			return fmt.Errorf("volume type %q does not exist in region %s, available volume types: %s",
+				name, region, strings.Join(available, ", "))
+		}
+	}
+	return nil
+}
+
+// collectVolumeTypes returns values of volume_type attributes found in the value of the attribute,
+// including ones nested in blocks.
+func collectVolumeTypes(v interface{}) []string {
+	var names []string
+	switch v := v.(type) {
+	case string:
+		if v != "" {
+			names = append(names, v)
+		}
+	case []interface{}:
+		for _, item := range v {
+			block, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for key, value := range block {
+				if _, ok := value.([]interface{}); ok || key == "volume_type" {
+					names = append(names, collectVolumeTypes(value)...)
+				}
+			}
+		}
+	}
+	return names
+}
+
+// customizeDiffVolumeTypesExist validates on plan that volume types referenced by the attributes exist.
+// Attributes are either volume_type ones or blocks with volume_type attributes.
+func customizeDiffVolumeTypesExist(keys ...string) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		var names []string
+		for _, key := range keys {
+			if d.HasChange(key) && d.NewValueKnown(key) {
+				names = append(names, collectVolumeTypes(d.Get(key))...)
+			}
+		}
+		if len(names) == 0 {
+			return nil
+		}
+
+		config := meta.(configer)
+		region := getRegion(d, config)
+		blockStorageClient, err := config.BlockStorageV3Client(region)
+		if err != nil {
+			return fmt.Errorf("error creating block storage client: %s", err)
+		}
+		return checkBlockStorageVolumeTypesExist(blockStorageClient, names, region)
+	}
+}
@@ -0,0 +1,57 @@
+package mcs
+
+import (
+	"fmt"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectVolumeTypes(t *testing.T) {
+	assert.Equal(t, []string{"ceph-ssd"}, collectVolumeTypes("ceph-ssd"))
+	assert.Empty(t, collectVolumeTypes(""))
+
+	shards := []interface{}{
+		map[string]interface{}{
+			"name":        "shard0",
+			"volume_type": "ceph-ssd",
+			"wal_volume": []interface{}{
+				map[string]interface{}{"size": 10, "volume_type": "ceph-hdd"},
+			},
+		},
+		map[string]interface{}{
+			"name":        "shard1",
+			"volume_type": "",
+			"wal_volume":  []interface{}{},
+		},
+	}
+	assert.ElementsMatch(t, []string{"ceph-ssd", "ceph-hdd"}, collectVolumeTypes(shards))
+}
+
+func TestCheckBlockStorageVolumeTypesExist(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/types", blockStorageVolumeTypesListFixture)
+
+	assert.NoError(t, checkBlockStorageVolumeTypesExist(fake.ServiceClient(), []string{"ceph-ssd", "type-hdd"}, "RegionOne"))
+	assert.EqualError(t, checkBlockStorageVolumeTypesExist(fake.ServiceClient(), []string{"ceph-ssd", "local-ssd"}, "RegionOne"),
+		`volume type "local-ssd" does not exist in region RegionOne, available volume types: ceph-hdd, ceph-ssd`)
+}
+
+func TestBlockStorageVolumeStateRefreshFunc(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/volumes/volume", fmt.Sprintf(blockStorageVolumeGetFixture, "error_extending"))
+
+	_, status, err := blockStorageVolumeStateRefreshFunc(fake.ServiceClient(), "volume")()
+	assert.Equal(t, "error_extending", status)
+	assert.EqualError(t, err, "there was an error with the volume: status is error_extending")
+
+	_, status, err = blockStorageVolumeStateRefreshFunc(fake.ServiceClient(), "missing")()
+	assert.NoError(t, err)
+	assert.Equal(t, blockStorageStatusDeleted, status)
+}
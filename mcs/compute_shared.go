@@ -0,0 +1,307 @@
+package mcs
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	computeInstanceCreateTimeout = 30
+	computeInstanceUpdateTimeout = 30
+	computeInstanceDeleteTimeout = 30
+	computeInstanceMinTimeout    = 3
+)
+
+const (
+	computeInstanceStatusBuild        = "BUILD"
+	computeInstanceStatusActive       = "ACTIVE"
+	computeInstanceStatusShutoff      = "SHUTOFF"
+	computeInstanceStatusResize       = "RESIZE"
+	computeInstanceStatusVerifyResize = "VERIFY_RESIZE"
+	computeInstanceStatusDeleted      = "DELETED"
+	computeInstanceStatusError        = "ERROR"
+)
+
+const (
+	computeInstancePowerStateActive  = "active"
+	computeInstancePowerStateShutoff = "shutoff"
+)
+
+// instanceExtended is a server with attributes of availability zone extension.
+type instanceExtended struct {
+	servers.Server
+	availabilityzones.ServerAvailabilityZoneExt
+}
+
+func computeInstanceGet(client *gophercloud.ServiceClient, id string) (*instanceExtended, error) {
+	var s instanceExtended
+	if err := servers.Get(client, id).ExtractInto(&s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func computeInstanceStateRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		s, err := servers.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return s, computeInstanceStatusDeleted, nil
+			}
+			return nil, "", err
+		}
+
+		if s.Status == computeInstanceStatusError {
+			return s, s.Status, fmt.Errorf("there was an error provisioning the compute instance: %s", s.Fault.Message)
+		}
+
+		return s, s.Status, nil
+	}
+}
+
+// computeInstanceDeleteStateRefreshFunc does not fail on ERROR status, so failed instances can be deleted.
+func computeInstanceDeleteStateRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		s, err := servers.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return s, computeInstanceStatusDeleted, nil
+			}
+			return nil, "", err
+		}
+
+		return s, s.Status, nil
+	}
+}
+
+// waitForComputeInstance waits for the instance to leave pending statuses. Pending statuses
+// include the status before the action, so the instance is polled without a delay.
+func waitForComputeInstance(client *gophercloud.ServiceClient, id string, pending, target []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    computeInstanceStateRefreshFunc(client, id),
+		Timeout:    timeout,
+		MinTimeout: computeInstanceMinTimeout * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for compute instance %s to become %s: %s", id, target, err)
+	}
+	return nil
+}
+
+// computeInstancePowerState returns the power state of the instance in ACTIVE or SHUTOFF status.
+// Other statuses are transitional or failed and do not change the power state.
+func computeInstancePowerState(status string) (string, bool) {
+	switch status {
+	case computeInstanceStatusActive:
+		return computeInstancePowerStateActive, true
+	case computeInstanceStatusShutoff:
+		return computeInstancePowerStateShutoff, true
+	default:
+		return "", false
+	}
+}
+
+func flattenComputeInstanceFlavorID(s *servers.Server) string {
+	id, _ := s.Flavor["id"].(string)
+	return id
+}
+
+// flattenComputeInstanceImageID returns empty ID for instances booted from volume.
+func flattenComputeInstanceImageID(s *servers.Server) string {
+	id, _ := s.Image["id"].(string)
+	return id
+}
+
+func flattenComputeInstanceSecurityGroups(s *servers.Server) []string {
+	names := make([]string, 0, len(s.SecurityGroups))
+	for _, sg := range s.SecurityGroups {
+		if name, ok := sg["name"].(string); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// flattenComputeInstanceAccessIPv4 falls back to the fixed IP of the first network.
+func flattenComputeInstanceAccessIPv4(s *servers.Server, networks []map[string]interface{}) string {
+	if s.AccessIPv4 != "" || len(networks) == 0 {
+		return s.AccessIPv4
+	}
+	ip, _ := networks[0]["fixed_ip_v4"].(string)
+	return ip
+}
+
+// computeInstancePorts returns ports of the instance.
+func computeInstancePorts(client *gophercloud.ServiceClient, id string) ([]ports.Port, error) {
+	allPages, err := ports.List(client, ports.ListOpts{DeviceID: id}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ports.ExtractPorts(allPages)
+}
+
+func computeInstancePortFixedIPv4(p ports.Port) string {
+	for _, ip := range p.FixedIPs {
+		if parsed := net.ParseIP(ip.IPAddress); parsed != nil && parsed.To4() != nil {
+			return ip.IPAddress
+		}
+	}
+	return ""
+}
+
+// flattenComputeInstanceNetworks matches ports of the instance to the configured networks by port or network ID.
+// All ports are flattened in order when no networks are configured, e.g. on import.
+func flattenComputeInstanceNetworks(configured []interface{}, instancePorts []ports.Port) []map[string]interface{} {
+	flattenPort := func(p ports.Port) map[string]interface{} {
+		return map[string]interface{}{
+			"uuid":        p.NetworkID,
+			"port":        p.ID,
+			"fixed_ip_v4": computeInstancePortFixedIPv4(p),
+			"mac":         p.MACAddress,
+		}
+	}
+
+	networks := make([]map[string]interface{}, 0, len(instancePorts))
+	if len(configured) == 0 {
+		for _, p := range instancePorts {
+			networks = append(networks, flattenPort(p))
+		}
+		return networks
+	}
+
+	used := make(map[string]bool, len(instancePorts))
+	for _, v := range configured {
+		network, _ := v.(map[string]interface{})
+		portID, _ := network["port"].(string)
+		networkID, _ := network["uuid"].(string)
+
+		matched := network
+		for _, p := range instancePorts {
+			if used[p.ID] {
+				continue
+			}
+			if (portID != "" && p.ID == portID) || (portID == "" && p.NetworkID == networkID) {
+				used[p.ID] = true
+				matched = flattenPort(p)
+				break
+			}
+		}
+		networks = append(networks, matched)
+	}
+	return networks
+}
+
+// checkComputeKeypairExists ensures that keypair exists in the region of the compute client.
+func checkComputeKeypairExists(client *gophercloud.ServiceClient, name, region string) error {
+	err := keypairs.Get(client, name, nil).Err
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return fmt.Errorf("keypair %q does not exist in region %s", name, region)
+		}
+		return fmt.Errorf("error retrieving keypair %s: %s", name, err)
+	}
+	return nil
+}
+
+// customizeDiffKeypairExists validates on plan that keypair referenced by the resource exists.
+func customizeDiffKeypairExists(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("keypair") || !d.NewValueKnown("keypair") {
+		return nil
+	}
+	name := d.Get("keypair").(string)
+	if name == "" {
+		return nil
+	}
+
+	config := meta.(configer)
+	region := getRegion(d, config)
+
+	computeClient, err := config.ComputeV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating compute client: %s", err)
+	}
+	return checkComputeKeypairExists(computeClient, name, region)
+}
+
+// computeFlavorFilter holds constraints flavors are selected by. Zero values match any flavor.
+type computeFlavorFilter struct {
+	Name     string
+	MinVCPUs int
+	MinRAM   int
+	MinDisk  int
+}
+
+func (f computeFlavorFilter) matches(flavor flavors.Flavor) bool {
+	return (f.Name == "" || flavor.Name == f.Name) &&
+		flavor.VCPUs >= f.MinVCPUs &&
+		flavor.RAM >= f.MinRAM &&
+		flavor.Disk >= f.MinDisk
+}
+
+// computeFlavorsFind returns flavors matching the filter, the smallest ones first.
+// Flavors are ordered by vCPUs, RAM, disk and name.
+func computeFlavorsFind(client *gophercloud.ServiceClient, filter computeFlavorFilter) ([]flavors.Flavor, error) {
+	listOpts := flavors.ListOpts{
+		MinRAM:     filter.MinRAM,
+		MinDisk:    filter.MinDisk,
+		AccessType: flavors.AllAccess,
+	}
+
+	allPages, err := flavors.ListDetail(client, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	allFlavors, err := flavors.ExtractFlavors(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]flavors.Flavor, 0, len(allFlavors))
+	for _, flavor := range allFlavors {
+		if filter.matches(flavor) {
+			matched = append(matched, flavor)
+		}
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		a, b := matched[i], matched[j]
+		if a.VCPUs != b.VCPUs {
+			return a.VCPUs < b.VCPUs
+		}
+		if a.RAM != b.RAM {
+			return a.RAM < b.RAM
+		}
+		if a.Disk != b.Disk {
+			return a.Disk < b.Disk
+		}
+		return a.Name < b.Name
+	})
+
+	return matched, nil
+}
+
+func flattenComputeFlavor(flavor flavors.Flavor) map[string]interface{} {
+	return map[string]interface{}{
+		"id":        flavor.ID,
+		"name":      flavor.Name,
+		"vcpus":     flavor.VCPUs,
+		"ram":       flavor.RAM,
+		"disk":      flavor.Disk,
+		"is_public": flavor.IsPublic,
+	}
+}
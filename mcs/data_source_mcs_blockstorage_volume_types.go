@@ -0,0 +1,74 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceBlockStorageVolumeTypes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBlockStorageVolumeTypesRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"volume_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBlockStorageVolumeTypesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	blockStorageClient, err := config.BlockStorageV3Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating block storage client: %s", err)
+	}
+
+	allVolumeTypes, err := blockStorageVolumeTypes(blockStorageClient)
+	if err != nil {
+		return fmt.Errorf("error retrieving mcs_blockstorage_volume_types: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_blockstorage_volume_types: %#v", allVolumeTypes)
+
+	volumeTypes := make([]map[string]interface{}, 0, len(allVolumeTypes))
+	for _, vt := range allVolumeTypes {
+		volumeTypes = append(volumeTypes, map[string]interface{}{
+			"id":          vt.ID,
+			"name":        vt.Name,
+			"description": vt.Description,
+		})
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.Set("region", getRegion(d, config))
+	if err := d.Set("volume_types", volumeTypes); err != nil {
+		return fmt.Errorf("error setting volume_types: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package mcs
+
+import (
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceBlockStorageVolumeTypesRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/types", blockStorageVolumeTypesListFixture)
+
+	config := &dummyConfig{}
+	config.On("BlockStorageV3Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, dataSourceBlockStorageVolumeTypes().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+
+	assert.NoError(t, dataSourceBlockStorageVolumeTypesRead(d, config))
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, 2, d.Get("volume_types.#"))
+	assert.Equal(t, "ceph-ssd", d.Get("volume_types.0.name"))
+	assert.Equal(t, "type-hdd", d.Get("volume_types.1.id"))
+}
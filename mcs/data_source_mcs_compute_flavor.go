@@ -0,0 +1,117 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceComputeFlavor() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeFlavorRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"flavor_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name", "min_vcpus", "min_ram", "min_disk"},
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"flavor_id"},
+			},
+			"min_vcpus": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(0),
+				ConflictsWith: []string{"flavor_id"},
+			},
+			"min_ram": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(0),
+				ConflictsWith: []string{"flavor_id"},
+			},
+			"min_disk": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(0),
+				ConflictsWith: []string{"flavor_id"},
+			},
+			"vcpus": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ram": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"disk": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"is_public": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeFlavorRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	computeClient, err := config.ComputeV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating compute client: %s", err)
+	}
+
+	var flavor flavors.Flavor
+	if id := d.Get("flavor_id").(string); id != "" {
+		f, err := flavors.Get(computeClient, id).Extract()
+		if err != nil {
+			return fmt.Errorf("error retrieving mcs_compute_flavor %s: %s", id, err)
+		}
+		flavor = *f
+	} else {
+		filter := computeFlavorFilter{
+			Name:     d.Get("name").(string),
+			MinVCPUs: d.Get("min_vcpus").(int),
+			MinRAM:   d.Get("min_ram").(int),
+			MinDisk:  d.Get("min_disk").(int),
+		}
+
+		matched, err := computeFlavorsFind(computeClient, filter)
+		if err != nil {
+			return fmt.Errorf("error retrieving mcs_compute_flavor: %s", err)
+		}
+
+		// Flavors matched by constraints only are ordered by size, the smallest one is chosen.
+		if len(matched) == 0 || (filter.Name != "" && len(matched) > 1) {
+			return fmt.Errorf("query matched %d mcs_compute_flavor, exactly one is expected", len(matched))
+		}
+		flavor = matched[0]
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_compute_flavor %s: %#v", flavor.ID, flavor)
+
+	d.SetId(flavor.ID)
+	d.Set("region", getRegion(d, config))
+	d.Set("flavor_id", flavor.ID)
+	d.Set("name", flavor.Name)
+	d.Set("vcpus", flavor.VCPUs)
+	d.Set("ram", flavor.RAM)
+	d.Set("disk", flavor.Disk)
+	d.Set("is_public", flavor.IsPublic)
+
+	return nil
+}
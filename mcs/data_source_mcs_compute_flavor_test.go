@@ -0,0 +1,82 @@
+package mcs
+
+import (
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceComputeFlavorRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/flavors/detail", computeFlavorListFixture)
+
+	config := &dummyConfig{}
+	config.On("ComputeV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	tests := []struct {
+		name   string
+		raw    map[string]interface{}
+		id     string
+		errMsg string
+	}{
+		{
+			name: "smallest matching constraints",
+			raw:  map[string]interface{}{"min_vcpus": 2, "min_ram": 4096},
+			id:   "flavor-medium-private",
+		},
+		{
+			name: "by name",
+			raw:  map[string]interface{}{"name": "Standard-2-4-40"},
+			id:   "flavor-medium",
+		},
+		{
+			name:   "nothing matches",
+			raw:    map[string]interface{}{"min_vcpus": 8},
+			errMsg: "query matched 0 mcs_compute_flavor, exactly one is expected",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.raw["region"] = "RegionOne"
+			d := schema.TestResourceDataRaw(t, dataSourceComputeFlavor().Schema, tt.raw)
+
+			err := dataSourceComputeFlavorRead(d, config)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.id, d.Id())
+			assert.Equal(t, tt.id, d.Get("flavor_id"))
+			assert.Equal(t, 2, d.Get("vcpus"))
+			assert.Equal(t, 4096, d.Get("ram"))
+		})
+	}
+}
+
+func TestDataSourceComputeFlavorsRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/flavors/detail", computeFlavorListFixture)
+
+	config := &dummyConfig{}
+	config.On("ComputeV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, dataSourceComputeFlavors().Schema, map[string]interface{}{
+		"region":   "RegionOne",
+		"min_disk": 40,
+	})
+
+	assert.NoError(t, dataSourceComputeFlavorsRead(d, config))
+	assert.Equal(t, []interface{}{"flavor-medium-private", "flavor-medium", "flavor-large"}, d.Get("ids"))
+	assert.Equal(t, "Private-2-4-40", d.Get("flavors.0.name"))
+	assert.Equal(t, false, d.Get("flavors.0.is_public"))
+	assert.Equal(t, 8192, d.Get("flavors.2.ram"))
+}
@@ -0,0 +1,117 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceComputeFlavors() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeFlavorsRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"min_vcpus": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"min_ram": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"min_disk": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vcpus": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ram": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"is_public": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceComputeFlavorsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	computeClient, err := config.ComputeV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating compute client: %s", err)
+	}
+
+	filter := computeFlavorFilter{
+		Name:     d.Get("name").(string),
+		MinVCPUs: d.Get("min_vcpus").(int),
+		MinRAM:   d.Get("min_ram").(int),
+		MinDisk:  d.Get("min_disk").(int),
+	}
+
+	matched, err := computeFlavorsFind(computeClient, filter)
+	if err != nil {
+		return fmt.Errorf("error retrieving mcs_compute_flavors: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_compute_flavors: %#v", matched)
+
+	ids := make([]string, 0, len(matched))
+	flattened := make([]map[string]interface{}, 0, len(matched))
+	for _, flavor := range matched {
+		ids = append(ids, flavor.ID)
+		flattened = append(flattened, flattenComputeFlavor(flavor))
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.Set("region", getRegion(d, config))
+	d.Set("ids", ids)
+	if err := d.Set("flavors", flattened); err != nil {
+		return fmt.Errorf("error setting flavors: %s", err)
+	}
+	return nil
+}
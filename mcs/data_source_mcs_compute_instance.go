@@ -0,0 +1,154 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceComputeInstance() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeInstanceRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"instance_id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"instance_id", "name"},
+			},
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"keypair": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_groups": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"network": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fixed_ip_v4": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mac": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"power_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_ip_v4": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	computeClient, err := config.ComputeV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating compute client: %s", err)
+	}
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	id := d.Get("instance_id").(string)
+	if id == "" {
+		// Name filter of the compute API is a regular expression.
+		listOpts := servers.ListOpts{
+			Name: fmt.Sprintf("^%s$", regexp.QuoteMeta(d.Get("name").(string))),
+		}
+
+		allPages, err := servers.List(computeClient, listOpts).AllPages()
+		if err != nil {
+			return fmt.Errorf("error retrieving mcs_compute_instance: %s", err)
+		}
+
+		allServers, err := servers.ExtractServers(allPages)
+		if err != nil {
+			return fmt.Errorf("error extracting mcs_compute_instance: %s", err)
+		}
+
+		if len(allServers) != 1 {
+			return fmt.Errorf("query matched %d mcs_compute_instance, exactly one is expected", len(allServers))
+		}
+		id = allServers[0].ID
+	}
+
+	s, err := computeInstanceGet(computeClient, id)
+	if err != nil {
+		return fmt.Errorf("error retrieving mcs_compute_instance %s: %s", id, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_compute_instance %s: %#v", id, s)
+
+	instancePorts, err := computeInstancePorts(networkingClient, id)
+	if err != nil {
+		return fmt.Errorf("error retrieving ports of mcs_compute_instance %s: %s", id, err)
+	}
+	networks := flattenComputeInstanceNetworks(nil, instancePorts)
+
+	d.SetId(s.ID)
+	d.Set("region", getRegion(d, config))
+	d.Set("instance_id", s.ID)
+	d.Set("name", s.Name)
+	d.Set("flavor_id", flattenComputeInstanceFlavorID(&s.Server))
+	d.Set("image_id", flattenComputeInstanceImageID(&s.Server))
+	d.Set("keypair", s.KeyName)
+	d.Set("availability_zone", s.AvailabilityZone)
+	d.Set("security_groups", flattenComputeInstanceSecurityGroups(&s.Server))
+	d.Set("network", networks)
+	if powerState, ok := computeInstancePowerState(s.Status); ok {
+		d.Set("power_state", powerState)
+	}
+	d.Set("access_ip_v4", flattenComputeInstanceAccessIPv4(&s.Server, networks))
+
+	return nil
+}
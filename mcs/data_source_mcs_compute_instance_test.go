@@ -0,0 +1,43 @@
+package mcs
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceComputeInstanceRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"name": `^bastion\.db$`})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"servers": [{"id": "instance", "name": "bastion.db"}]}`)
+	})
+	handleGetFixture(t, "/servers/instance", fmt.Sprintf(computeInstanceGetFixture, "ACTIVE"))
+	handleGetFixture(t, "/ports", computeInstancePortListFixture)
+
+	config := &dummyConfig{}
+	config.On("ComputeV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+	config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, dataSourceComputeInstance().Schema, map[string]interface{}{
+		"region": "RegionOne",
+		"name":   "bastion.db",
+	})
+
+	assert.NoError(t, dataSourceComputeInstanceRead(d, config))
+	assert.Equal(t, "instance", d.Id())
+	assert.Equal(t, "active", d.Get("power_state"))
+	assert.Equal(t, "port", d.Get("network.0.port"))
+	assert.Equal(t, "fa:16:3e:00:00:01", d.Get("network.0.mac"))
+}
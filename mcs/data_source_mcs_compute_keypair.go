@@ -0,0 +1,57 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceComputeKeypair() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeKeypairRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"public_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeKeypairRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	computeClient, err := config.ComputeV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating compute client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	kp, err := keypairs.Get(computeClient, name, nil).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving mcs_compute_keypair %s: %s", name, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_compute_keypair %s: %#v", name, kp)
+
+	d.SetId(kp.Name)
+	d.Set("region", getRegion(d, config))
+	d.Set("public_key", kp.PublicKey)
+	d.Set("fingerprint", kp.Fingerprint)
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceImagesImage() *schema.Resource {
+	s := imagesFilterSchema()
+	s["most_recent"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	for _, key := range []string{"container_format", "disk_format", "checksum", "created_at", "updated_at"} {
+		s[key] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+	for _, key := range []string{"min_disk_gb", "min_ram_mb", "size_bytes"} {
+		s[key] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		}
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceImagesImageRead,
+		Schema: s,
+	}
+}
+
+func dataSourceImagesImageRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	imageClient, err := config.ImageV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating image client: %s", err)
+	}
+
+	matched, err := imagesFind(imageClient, d)
+	if err != nil {
+		return fmt.Errorf("error retrieving mcs_images_image: %s", err)
+	}
+
+	// Matched images are ordered by creation date, the first one is the most recent.
+	if len(matched) == 0 || (len(matched) > 1 && !d.Get("most_recent").(bool)) {
+		return fmt.Errorf("query matched %d mcs_images_image, exactly one is expected, "+
+			"set most_recent to choose the most recent one", len(matched))
+	}
+	image := matched[0]
+
+	log.Printf("[DEBUG] Retrieved mcs_images_image %s: %#v", image.ID, image)
+
+	d.SetId(image.ID)
+	d.Set("region", getRegion(d, config))
+	for key, value := range flattenImage(image) {
+		if key == "id" {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("error setting %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
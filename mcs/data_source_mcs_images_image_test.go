@@ -0,0 +1,86 @@
+package mcs
+
+import (
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceImagesImageRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/images", imagesListFixture)
+
+	config := &dummyConfig{}
+	config.On("ImageV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	tests := []struct {
+		name   string
+		raw    map[string]interface{}
+		id     string
+		errMsg string
+	}{
+		{
+			name:   "several images match",
+			raw:    map[string]interface{}{"name": "ubuntu-20.04"},
+			errMsg: "query matched 2 mcs_images_image, exactly one is expected, set most_recent to choose the most recent one",
+		},
+		{
+			name: "most recent",
+			raw:  map[string]interface{}{"name": "ubuntu-20.04", "most_recent": true},
+			id:   "image-new",
+		},
+		{
+			name: "properties",
+			raw: map[string]interface{}{
+				"name":       "ubuntu-20.04",
+				"properties": map[string]interface{}{"hw_qemu_guest_agent": "yes"},
+			},
+			id: "image-old",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.raw["region"] = "RegionOne"
+			d := schema.TestResourceDataRaw(t, dataSourceImagesImage().Schema, tt.raw)
+
+			err := dataSourceImagesImageRead(d, config)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.id, d.Id())
+			assert.Equal(t, "raw", d.Get("disk_format"))
+			assert.Equal(t, 10, d.Get("min_disk_gb"))
+			assert.Equal(t, 2147483648, d.Get("size_bytes"))
+			assert.Equal(t, "ubuntu", d.Get("properties.os_distro"))
+			assert.True(t, d.Get("tags").(*schema.Set).Contains("worker"))
+		})
+	}
+}
+
+func TestDataSourceImagesImagesRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/images", imagesListFixture)
+
+	config := &dummyConfig{}
+	config.On("ImageV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, dataSourceImagesImages().Schema, map[string]interface{}{
+		"region":     "RegionOne",
+		"properties": map[string]interface{}{"os_distro": "ubuntu"},
+	})
+
+	assert.NoError(t, dataSourceImagesImagesRead(d, config))
+	assert.Equal(t, []interface{}{"image-new", "image-old"}, d.Get("ids"))
+	assert.Equal(t, "2021-06-01T10:00:00Z", d.Get("images.0.created_at"))
+	assert.Equal(t, "yes", d.Get("images.1.properties.hw_qemu_guest_agent"))
+}
@@ -0,0 +1,72 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceImagesImages() *schema.Resource {
+	s := imagesFilterSchema()
+	s["ids"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	image := dataSourceImagesImage().Schema
+	delete(image, "region")
+	delete(image, "most_recent")
+	image["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	for _, v := range image {
+		v.Optional = false
+		v.Computed = true
+		v.ValidateFunc = nil
+	}
+	s["images"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Resource{Schema: image},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceImagesImagesRead,
+		Schema: s,
+	}
+}
+
+func dataSourceImagesImagesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	imageClient, err := config.ImageV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating image client: %s", err)
+	}
+
+	matched, err := imagesFind(imageClient, d)
+	if err != nil {
+		return fmt.Errorf("error retrieving mcs_images_images: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_images_images: %#v", matched)
+
+	ids := make([]string, 0, len(matched))
+	flattened := make([]map[string]interface{}, 0, len(matched))
+	for _, image := range matched {
+		ids = append(ids, image.ID)
+		flattened = append(flattened, flattenImage(image))
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.Set("region", getRegion(d, config))
+	d.Set("ids", ids)
+	if err := d.Set("images", flattened); err != nil {
+		return fmt.Errorf("error setting images: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceKubernetesAddons() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesAddonsRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"available_addons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"versions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_values": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"installed_addons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"addon_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenAvailableAddons(addons []availableAddon) []map[string]interface{} {
+	flatSchema := make([]map[string]interface{}, 0, len(addons))
+	for _, a := range addons {
+		flatSchema = append(flatSchema, map[string]interface{}{
+			"name":           a.Name,
+			"versions":       a.Versions,
+			"description":    a.Description,
+			"default_values": a.DefaultValues,
+		})
+	}
+	return flatSchema
+}
+
+func flattenInstalledAddons(addons []addon) []map[string]interface{} {
+	flatSchema := make([]map[string]interface{}, 0, len(addons))
+	for _, a := range addons {
+		flatSchema = append(flatSchema, map[string]interface{}{
+			"addon_id":  a.ID,
+			"name":      a.Name,
+			"version":   a.Version,
+			"namespace": a.Namespace,
+			"status":    a.Status,
+		})
+	}
+	return flatSchema
+}
+
+func dataSourceKubernetesAddonsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	containerInfraClient, err := config.ContainerInfraV1Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating container infra client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+
+	available, err := availableAddonList(containerInfraClient, clusterID).Extract()
+	if err != nil {
+		return fmt.Errorf("error listing available addons of mcs_kubernetes_cluster %s: %s", clusterID, err)
+	}
+
+	installed, err := addonList(containerInfraClient, clusterID).Extract()
+	if err != nil {
+		return fmt.Errorf("error listing installed addons of mcs_kubernetes_cluster %s: %s", clusterID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved addons of mcs_kubernetes_cluster %s", clusterID)
+
+	d.SetId(clusterID)
+	if err := d.Set("available_addons", flattenAvailableAddons(available)); err != nil {
+		return fmt.Errorf("unable to set available_addons: %s", err)
+	}
+	if err := d.Set("installed_addons", flattenInstalledAddons(installed)); err != nil {
+		return fmt.Errorf("unable to set installed_addons: %s", err)
+	}
+	d.Set("region", getRegion(d, config))
+
+	return nil
+}
@@ -53,8 +53,10 @@ func dataSourceKubernetesClusterTemplate() *schema.Resource {
 				Computed: true,
 			},
 			"cluster_distro": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name", "cluster_template_uuid"},
 			},
 			"dns_nameserver": {
 				Type:     schema.TypeString,
@@ -105,8 +107,10 @@ func dataSourceKubernetesClusterTemplate() *schema.Resource {
 				Computed: true,
 			},
 			"network_driver": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name", "cluster_template_uuid"},
 			},
 			"no_proxy": {
 				Type:     schema.TypeString,
@@ -142,11 +146,35 @@ func dataSourceKubernetesClusterTemplateRead(d *schema.ResourceData, meta interf
 	if err != nil {
 		return fmt.Errorf("error creating OpenStack container infra client: %s", err)
 	}
+	filter := clusterTemplateFilter{
+		Version:       d.Get("version").(string),
+		ClusterDistro: d.Get("cluster_distro").(string),
+		NetworkDriver: d.Get("network_driver").(string),
+	}
+
 	templateIdentifierKey, err := ensureOnlyOnePresented(d, "name", "version", "cluster_template_uuid")
 	if err != nil {
-		return err
+		// Filters without version select the latest template.
+		if filter.ClusterDistro == "" && filter.NetworkDriver == "" {
+			return err
+		}
+		templateIdentifierKey = "version"
 	}
+
 	templateIdentifier := d.Get(templateIdentifierKey).(string)
+	if templateIdentifierKey == "version" {
+		templates, err := clusterTemplateList(containerInfraClient).Extract()
+		if err != nil {
+			return fmt.Errorf("failed to list cluster templates: %s", err)
+		}
+		selected, err := selectClusterTemplate(templates, filter)
+		if err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] Selected mcs_kubernetes_clustertemplate %s of version %s", selected.UUID, selected.Version)
+		templateIdentifier = selected.UUID
+	}
+
 	var ct *clusterTemplate
 	ct, err = clusterTemplateGet(containerInfraClient, templateIdentifier).Extract()
 	if err != nil {
@@ -155,6 +183,7 @@ func dataSourceKubernetesClusterTemplateRead(d *schema.ResourceData, meta interf
 
 	d.SetId(ct.UUID)
 
+	d.Set("cluster_template_uuid", ct.UUID)
 	d.Set("project_id", ct.ProjectID)
 	d.Set("user_id", ct.UserID)
 	d.Set("apiserver_port", ct.APIServerPort)
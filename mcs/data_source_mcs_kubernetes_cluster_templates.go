@@ -29,6 +29,107 @@ func dataSourceKubernetesClusterTemplates() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"apiserver_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"cluster_distro": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_nameserver": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"docker_storage_driver": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"docker_volume_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"external_network_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"flavor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"master_flavor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"floating_ip_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"image": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"insecure_registry": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"keypair_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"master_lb_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"network_driver": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"no_proxy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"registry_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"server_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tls_disabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"volume_driver": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -36,21 +137,40 @@ func dataSourceKubernetesClusterTemplates() *schema.Resource {
 	}
 }
 
-type clusterTemplateResponse struct {
-	Version string
-	UUID    string
-	Name    string
-}
-
 type clusterTemplateFlatSchema []map[string]interface{}
 
-func flattenClusterTemplates(templates []clusterTemplateResponse) clusterTemplateFlatSchema {
+func flattenClusterTemplates(templates []clusterTemplate) clusterTemplateFlatSchema {
 	flatSchema := clusterTemplateFlatSchema{}
 	for _, template := range templates {
 		flatSchema = append(flatSchema, map[string]interface{}{
 			"name":                  template.Name,
 			"cluster_template_uuid": template.UUID,
 			"version":               template.Version,
+			"project_id":            template.ProjectID,
+			"user_id":               template.UserID,
+			"created_at":            template.CreatedAt.Format(time.RFC3339),
+			"updated_at":            template.UpdatedAt.Format(time.RFC3339),
+			"apiserver_port":        template.APIServerPort,
+			"cluster_distro":        template.ClusterDistro,
+			"dns_nameserver":        template.DNSNameServer,
+			"docker_storage_driver": template.DockerStorageDriver,
+			"docker_volume_size":    template.DockerVolumeSize,
+			"external_network_id":   template.ExternalNetworkID,
+			"flavor":                template.FlavorID,
+			"master_flavor":         template.MasterFlavorID,
+			"floating_ip_enabled":   template.FloatingIPEnabled,
+			"image":                 template.ImageID,
+			"insecure_registry":     template.InsecureRegistry,
+			"keypair_id":            template.KeyPairID,
+			"labels":                template.Labels,
+			"master_lb_enabled":     template.MasterLBEnabled,
+			"network_driver":        template.NetworkDriver,
+			"no_proxy":              template.NoProxy,
+			"public":                template.Public,
+			"registry_enabled":      template.RegistryEnabled,
+			"server_type":           template.ServerType,
+			"tls_disabled":          template.TLSDisabled,
+			"volume_driver":         template.VolumeDriver,
 		})
 	}
 	return flatSchema
@@ -68,17 +188,8 @@ func dataSourceMcsClusterTemplatesRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("failed to list cluster templates: %s", err)
 	}
 
-	clusterTemplates := make([]clusterTemplateResponse, 0, len(templates))
-	for _, t := range templates {
-		clusterTemplates = append(clusterTemplates, clusterTemplateResponse{
-			UUID:    t.UUID,
-			Name:    t.Name,
-			Version: t.Version,
-		})
-	}
-
 	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-	if err := d.Set("cluster_templates", flattenClusterTemplates(clusterTemplates)); err != nil {
+	if err := d.Set("cluster_templates", flattenClusterTemplates(templates)); err != nil {
 		return fmt.Errorf("failed to set cluster templates: %s", err)
 	}
 
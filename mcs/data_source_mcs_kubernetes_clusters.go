@@ -0,0 +1,185 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceKubernetesClusters() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesClustersRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cluster_template_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"clusters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_template_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"api_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// clusterListFilter contains conditions clusters should match.
+type clusterListFilter struct {
+	NameRegex         *regexp.Regexp
+	Status            clusterStatus
+	ClusterTemplateID string
+	Labels            map[string]string
+}
+
+// match reports whether the cluster satisfies all the filter conditions.
+func (f *clusterListFilter) match(c *cluster) bool {
+	if f.NameRegex != nil && !f.NameRegex.MatchString(c.Name) {
+		return false
+	}
+	if f.Status != "" && f.Status != c.NewStatus && f.Status != c.Status {
+		return false
+	}
+	if f.ClusterTemplateID != "" && f.ClusterTemplateID != c.ClusterTemplateID {
+		return false
+	}
+	for k, v := range f.Labels {
+		if label, ok := c.Labels[k]; !ok || label != v {
+			return false
+		}
+	}
+	return true
+}
+
+func filterKubernetesClusters(clusters []cluster, filter *clusterListFilter) []cluster {
+	filtered := make([]cluster, 0, len(clusters))
+	for _, c := range clusters {
+		if filter.match(&c) {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+func flattenKubernetesClusters(clusters []*cluster) []map[string]interface{} {
+	flatSchema := make([]map[string]interface{}, 0, len(clusters))
+	for _, c := range clusters {
+		flatSchema = append(flatSchema, map[string]interface{}{
+			"cluster_id":          c.UUID,
+			"name":                c.Name,
+			"status":              string(c.NewStatus),
+			"cluster_template_id": c.ClusterTemplateID,
+			"api_address":         c.APIAddress,
+			"labels":              c.Labels,
+		})
+	}
+	return flatSchema
+}
+
+func dataSourceKubernetesClustersRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	containerInfraClient, err := config.ContainerInfraV1Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating container infra client: %s", err)
+	}
+
+	filter := clusterListFilter{
+		Status:            clusterStatus(d.Get("status").(string)),
+		ClusterTemplateID: d.Get("cluster_template_id").(string),
+	}
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		filter.NameRegex = regexp.MustCompile(nameRegex.(string))
+	}
+	labels, err := extractKubernetesLabelsMap(d.Get("labels").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+	filter.Labels = labels
+
+	allClusters, err := clusterList(containerInfraClient).Extract()
+	if err != nil {
+		return fmt.Errorf("failed to list mcs_kubernetes_clusters: %s", err)
+	}
+
+	filtered := filterKubernetesClusters(allClusters, &filter)
+	log.Printf("[DEBUG] Found %d of %d mcs_kubernetes_clusters matching the filter", len(filtered), len(allClusters))
+
+	// Clusters list lacks some attributes (e.g. api_address), so get every found cluster.
+	ids := make([]string, 0, len(filtered))
+	found := make([]*cluster, 0, len(filtered))
+	for _, c := range filtered {
+		clusterDetails, err := clusterGet(containerInfraClient, c.UUID).Extract()
+		if err != nil {
+			return fmt.Errorf("error getting mcs_kubernetes_cluster %s: %s", c.UUID, err)
+		}
+		ids = append(ids, clusterDetails.UUID)
+		found = append(found, clusterDetails)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("failed to set ids: %s", err)
+	}
+	if err := d.Set("clusters", flattenKubernetesClusters(found)); err != nil {
+		return fmt.Errorf("failed to set clusters: %s", err)
+	}
+	d.Set("region", getRegion(d, config))
+
+	return nil
+}
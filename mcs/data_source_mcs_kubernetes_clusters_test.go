@@ -0,0 +1,126 @@
+package mcs
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterKubernetesClusters(t *testing.T) {
+	clusters := []cluster{
+		{
+			UUID:              "1",
+			Name:              "prod-app",
+			NewStatus:         clusterStatusRunning,
+			ClusterTemplateID: "template-1",
+			Labels:            map[string]string{"team": "app", "env": "prod"},
+		},
+		{
+			UUID:              "2",
+			Name:              "dev-app",
+			NewStatus:         clusterStatusShutoff,
+			ClusterTemplateID: "template-1",
+			Labels:            map[string]string{"team": "app", "env": "dev"},
+		},
+		{
+			UUID:              "3",
+			Name:              "prod-db",
+			NewStatus:         clusterStatusRunning,
+			ClusterTemplateID: "template-2",
+		},
+	}
+
+	tests := map[string]struct {
+		filter clusterListFilter
+		ids    []string
+	}{
+		"no filter":    {filter: clusterListFilter{}, ids: []string{"1", "2", "3"}},
+		"name regex":   {filter: clusterListFilter{NameRegex: regexp.MustCompile("^prod-")}, ids: []string{"1", "3"}},
+		"status":       {filter: clusterListFilter{Status: clusterStatusShutoff}, ids: []string{"2"}},
+		"template":     {filter: clusterListFilter{ClusterTemplateID: "template-2"}, ids: []string{"3"}},
+		"labels":       {filter: clusterListFilter{Labels: map[string]string{"team": "app"}}, ids: []string{"1", "2"}},
+		"all labels":   {filter: clusterListFilter{Labels: map[string]string{"team": "app", "env": "dev"}}, ids: []string{"2"}},
+		"no match":     {filter: clusterListFilter{NameRegex: regexp.MustCompile("^stage-")}, ids: []string{}},
+		"combined":     {filter: clusterListFilter{Status: clusterStatusRunning, ClusterTemplateID: "template-1"}, ids: []string{"1"}},
+		"unset labels": {filter: clusterListFilter{Labels: map[string]string{"env": "prod"}}, ids: []string{"1"}},
+	}
+
+	for name := range tests {
+		tt := tests[name]
+		t.Run(name, func(t *testing.T) {
+			ids := []string{}
+			for _, c := range filterKubernetesClusters(clusters, &tt.filter) {
+				ids = append(ids, c.UUID)
+			}
+			assert.Equal(t, tt.ids, ids)
+		})
+	}
+}
+
+func TestAccKubernetesDataSourceClusters(t *testing.T) {
+	tests := map[string]struct {
+		name     string
+		testCase resource.TestCase
+	}{
+		"no filter": {
+			name: "data.mcs_kubernetes_clusters.all",
+			testCase: resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDataSourceMCSKubernetesClustersConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testAccDataSourceMCSKubernetesClustersCheck("data.mcs_kubernetes_clusters.all"),
+						),
+					},
+				},
+			},
+		},
+	}
+
+	for name := range tests {
+		tt := tests[name]
+		t.Run(name, func(t *testing.T) {
+			resource.ParallelTest(t, tt.testCase)
+		})
+	}
+}
+
+func testAccDataSourceMCSKubernetesClustersConfig() string {
+	return `
+data "mcs_kubernetes_clusters" "all" {}
+`
+}
+
+func testAccDataSourceMCSKubernetesClustersCheck(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("root module has no resource called %s", resourceName)
+		}
+
+		ids, ok := rs.Primary.Attributes["ids.#"]
+		if !ok {
+			return fmt.Errorf("ids attribute is missing")
+		}
+		clusters, ok := rs.Primary.Attributes["clusters.#"]
+		if !ok {
+			return fmt.Errorf("clusters attribute is missing")
+		}
+
+		idsQuantity, err := strconv.Atoi(ids)
+		if err != nil {
+			return fmt.Errorf("error parsing ids (%s) into integer: %s", ids, err)
+		}
+		if ids != clusters {
+			return fmt.Errorf("mismatched quantity of ids (%d) and clusters (%s)", idsQuantity, clusters)
+		}
+
+		return nil
+	}
+}
@@ -50,11 +50,27 @@ func dataSourceKubernetesNodeGroup() *schema.Resource {
 				Optional: true,
 				Computed: false,
 			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"autoscaling_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Computed: false,
 			},
+			"auto_repair": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"healthy_node_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"uuid": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -80,6 +96,10 @@ func dataSourceKubernetesNodeGroup() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"created_at": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -96,6 +116,12 @@ func dataSourceKubernetesNodeGroup() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -123,10 +149,15 @@ func dataSourceKubernetesNodeGroupRead(d *schema.ResourceData, meta interface{})
 	d.Set("volume_size", nodeGroup.VolumeSize)
 	d.Set("volume_type", nodeGroup.VolumeType)
 	d.Set("flavor_id", nodeGroup.FlavorID)
+	d.Set("image_id", nodeGroup.ImageID)
+	d.Set("cluster_template_id", nodeGroup.ClusterTemplateID)
 	d.Set("autoscaling_enabled", nodeGroup.Autoscaling)
+	d.Set("auto_repair", nodeGroup.AutoRepair)
 	d.Set("nodes", flattenNodes(nodeGroup.Nodes))
+	d.Set("healthy_node_count", countHealthyNodes(nodeGroup.Nodes))
 	d.Set("state", nodeGroup.State)
 	d.Set("availability_zones", nodeGroup.AvailabilityZones)
+	d.Set("security_group_ids", nodeGroup.SecurityGroups)
 
 	if err := d.Set("created_at", getTimestamp(&nodeGroup.CreatedAt)); err != nil {
 		log.Printf("[DEBUG] Unable to set mcs_kubernetes_node_group created_at: %s", err)
@@ -0,0 +1,111 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/external"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceNetworkingNetwork() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkingNetworkRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"external": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"port_security_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"subnets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	listOpts := external.ListOptsExt{
+		ListOptsBuilder: networks.ListOpts{
+			ID:     d.Get("network_id").(string),
+			Name:   d.Get("name").(string),
+			Status: d.Get("status").(string),
+		},
+	}
+	if v, ok := d.GetOkExists("external"); ok {
+		isExternal := v.(bool)
+		listOpts.External = &isExternal
+	}
+
+	allPages, err := networks.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("error retrieving mcs_networking_network: %s", err)
+	}
+
+	var allNetworks []networkExtended
+	if err := networks.ExtractNetworksInto(allPages, &allNetworks); err != nil {
+		return fmt.Errorf("error extracting mcs_networking_network: %s", err)
+	}
+
+	if len(allNetworks) != 1 {
+		return fmt.Errorf("query matched %d mcs_networking_network, exactly one is expected", len(allNetworks))
+	}
+
+	n := allNetworks[0]
+
+	log.Printf("[DEBUG] Retrieved mcs_networking_network %s: %#v", n.ID, n)
+
+	d.SetId(n.ID)
+	d.Set("region", getRegion(d, config))
+	d.Set("network_id", n.ID)
+	d.Set("name", n.Name)
+	d.Set("status", n.Status)
+	d.Set("external", n.External)
+	d.Set("description", n.Description)
+	d.Set("admin_state_up", n.AdminStateUp)
+	d.Set("port_security_enabled", n.PortSecurityEnabled)
+	d.Set("subnets", n.Subnets)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package mcs
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceNetworkingNetworkRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"name": "ext-net", "router:external": "true"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"networks": [{
+			"id": "ext-net",
+			"name": "ext-net",
+			"status": "ACTIVE",
+			"admin_state_up": true,
+			"router:external": true,
+			"subnets": ["ext-subnet"]
+		}]}`)
+	})
+
+	config := &dummyConfig{}
+	config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetworkingNetwork().Schema, map[string]interface{}{
+		"region":   "RegionOne",
+		"name":     "ext-net",
+		"external": true,
+	})
+
+	assert.NoError(t, dataSourceNetworkingNetworkRead(d, config))
+	assert.Equal(t, "ext-net", d.Id())
+	assert.Equal(t, "ACTIVE", d.Get("status"))
+	assert.Equal(t, true, d.Get("external"))
+	assert.Equal(t, []interface{}{"ext-subnet"}, d.Get("subnets"))
+}
@@ -0,0 +1,98 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceNetworkingRouter() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkingRouterRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"router_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"external_network_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"external_fixed_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingRouterRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	listOpts := routers.ListOpts{
+		ID:     d.Get("router_id").(string),
+		Name:   d.Get("name").(string),
+		Status: d.Get("status").(string),
+	}
+
+	allPages, err := routers.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("error retrieving mcs_networking_router: %s", err)
+	}
+
+	allRouters, err := routers.ExtractRouters(allPages)
+	if err != nil {
+		return fmt.Errorf("error extracting mcs_networking_router: %s", err)
+	}
+
+	if len(allRouters) != 1 {
+		return fmt.Errorf("query matched %d mcs_networking_router, exactly one is expected", len(allRouters))
+	}
+
+	r := allRouters[0]
+
+	log.Printf("[DEBUG] Retrieved mcs_networking_router %s: %#v", r.ID, r)
+
+	d.SetId(r.ID)
+	d.Set("region", getRegion(d, config))
+	d.Set("router_id", r.ID)
+	d.Set("name", r.Name)
+	d.Set("status", r.Status)
+	d.Set("description", r.Description)
+	d.Set("admin_state_up", r.AdminStateUp)
+	d.Set("external_network_id", r.GatewayInfo.NetworkID)
+	d.Set("external_fixed_ips", flattenNetworkingRouterExternalFixedIPs(r.GatewayInfo.ExternalFixedIPs))
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceNetworkingSubnet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkingSubnetRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cidr": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gateway_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enable_dhcp": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"dns_nameservers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"allocation_pool": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingSubnetRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	listOpts := subnets.ListOpts{
+		ID:        d.Get("subnet_id").(string),
+		Name:      d.Get("name").(string),
+		NetworkID: d.Get("network_id").(string),
+		CIDR:      d.Get("cidr").(string),
+	}
+
+	allPages, err := subnets.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("error retrieving mcs_networking_subnet: %s", err)
+	}
+
+	allSubnets, err := subnets.ExtractSubnets(allPages)
+	if err != nil {
+		return fmt.Errorf("error extracting mcs_networking_subnet: %s", err)
+	}
+
+	if len(allSubnets) != 1 {
+		return fmt.Errorf("query matched %d mcs_networking_subnet, exactly one is expected", len(allSubnets))
+	}
+
+	s := allSubnets[0]
+
+	log.Printf("[DEBUG] Retrieved mcs_networking_subnet %s: %#v", s.ID, s)
+
+	d.SetId(s.ID)
+	d.Set("region", getRegion(d, config))
+	d.Set("subnet_id", s.ID)
+	d.Set("name", s.Name)
+	d.Set("network_id", s.NetworkID)
+	d.Set("cidr", s.CIDR)
+	d.Set("description", s.Description)
+	d.Set("gateway_ip", s.GatewayIP)
+	d.Set("enable_dhcp", s.EnableDHCP)
+	d.Set("dns_nameservers", s.DNSNameservers)
+	d.Set("allocation_pool", flattenNetworkingSubnetAllocationPools(s.AllocationPools))
+
+	return nil
+}
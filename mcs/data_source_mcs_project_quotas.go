@@ -0,0 +1,71 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var projectQuotasCategories = []string{quotaCategoryCompute, quotaCategoryVolume, quotaCategoryNetwork, quotaCategoryDBaaS}
+
+func dataSourceProjectQuotas() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"project_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	for _, category := range projectQuotasCategories {
+		for _, kind := range []string{"limits", "usage"} {
+			s[category+"_"+kind] = &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			}
+		}
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceProjectQuotasRead,
+		Schema: s,
+	}
+}
+
+func dataSourceProjectQuotasRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	region := getRegion(d, config)
+
+	allQuotas, err := getProjectQuotas(config, region, projectQuotasCategories...)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_project_quotas: %#v", allQuotas)
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.Set("region", region)
+	d.Set("project_id", config.GetProjectID())
+	for _, category := range projectQuotasCategories {
+		limits := make(map[string]int, len(allQuotas[category]))
+		usage := make(map[string]int, len(allQuotas[category]))
+		for resource, q := range allQuotas[category] {
+			limits[resource] = q.Limit
+			usage[resource] = q.Used
+		}
+		if err := d.Set(category+"_limits", limits); err != nil {
+			return fmt.Errorf("error setting %s_limits: %s", category, err)
+		}
+		if err := d.Set(category+"_usage", usage); err != nil {
+			return fmt.Errorf("error setting %s_usage: %s", category, err)
+		}
+	}
+	return nil
+}
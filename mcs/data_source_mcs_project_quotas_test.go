@@ -0,0 +1,51 @@
+package mcs
+
+import (
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupProjectQuotasFixtures(t *testing.T, config *dummyConfig) {
+	handleGetFixture(t, "/limits", computeLimitsFixture)
+	handleGetFixture(t, "/os-quota-sets/project", blockStorageQuotaUsageFixture)
+	handleGetFixture(t, "/quotas/project/details.json", networkingQuotaDetailFixture)
+	handleGetFixture(t, "/db/limits", databaseLimitsFixture)
+	handleGetFixture(t, "/db/instances", databaseInstanceListFixture)
+	handleGetFixture(t, "/flavors/flavor-medium", `{"flavor": {"id": "flavor-medium", "vcpus": 2, "ram": 4096}}`)
+
+	databaseClient := fake.ServiceClient()
+	databaseClient.Endpoint = th.Endpoint() + "db/"
+
+	config.On("ComputeV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+	config.On("BlockStorageV3Client", "RegionOne").Return(fake.ServiceClient(), nil)
+	config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+	config.On("DatabaseV1Client", "RegionOne").Return(databaseClient, nil)
+	config.On("GetProjectID").Return("project")
+}
+
+func TestDataSourceProjectQuotasRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	config := &dummyConfig{}
+	setupProjectQuotasFixtures(t, config)
+
+	d := schema.TestResourceDataRaw(t, dataSourceProjectQuotas().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+
+	assert.NoError(t, dataSourceProjectQuotasRead(d, config))
+	assert.Equal(t, "project", d.Get("project_id"))
+	assert.Equal(t, 20, d.Get("compute_limits.cores"))
+	assert.Equal(t, 19, d.Get("compute_usage.cores"))
+	assert.Equal(t, 6, d.Get("volume_usage.volumes"))
+	assert.Equal(t, -1, d.Get("volume_limits.snapshots"))
+	assert.Equal(t, 3, d.Get("network_usage.floatingip"))
+	assert.Equal(t, 5, d.Get("dbaas_limits.instances"))
+	assert.Equal(t, 2, d.Get("dbaas_usage.instances"))
+	assert.Equal(t, 60, d.Get("dbaas_usage.volumes"))
+}
@@ -19,6 +19,7 @@ type dbClusterCreateOpts struct {
 	AutoExpand        int                           `json:"volume_autoresize_enabled,omitempty"`
 	MaxDiskSize       int                           `json:"volume_autoresize_max_size,omitempty"`
 	Instances         []dbClusterInstanceCreateOpts `json:"instances"`
+	Metadata          map[string]string             `json:"metadata,omitempty"`
 }
 
 // dbClusterInstanceCreateOpts represents database cluster instance creation parameters
@@ -135,6 +136,7 @@ type dbClusterResp struct {
 	Name            string                  `json:"name"`
 	Task            dbClusterTask           `json:"task"`
 	Updated         dateTimeWithoutTZFormat `json:"updated"`
+	Metadata        map[string]string       `json:"metadata"`
 }
 
 // dbClusterInstanceResp represents database cluster instance response
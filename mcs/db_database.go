@@ -57,3 +57,19 @@ func databaseDatabaseExists(client databaseClient, dbmsID string, databaseName s
 
 	return exists, err
 }
+
+// getDBMSTags returns tags of the database instance or cluster.
+func getDBMSTags(client databaseClient, dbmsID string, dbmsType string) (map[string]string, error) {
+	if dbmsType == dbmsTypeCluster {
+		c, err := dbClusterGet(client, dbmsID).extract()
+		if err != nil {
+			return nil, err
+		}
+		return c.Metadata, nil
+	}
+	i, err := instanceGet(client, dbmsID).extract()
+	if err != nil {
+		return nil, err
+	}
+	return i.Metadata, nil
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/mitchellh/mapstructure"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -102,6 +104,90 @@ func databaseInstanceStateRefreshFunc(client databaseClient, instanceID string)
 	}
 }
 
+// databaseInstancePorts returns ports of the instance in the network.
+// Empty network selects ports of the instance in all networks.
+func databaseInstancePorts(networkingClient *gophercloud.ServiceClient, instance *instanceResp, networkID string) ([]ports.Port, error) {
+	// The instance has no ports until its server is created.
+	if instance.СomputeInstanceID == "" {
+		return nil, nil
+	}
+	listOpts := ports.ListOpts{
+		DeviceID:  instance.СomputeInstanceID,
+		NetworkID: networkID,
+	}
+	allPages, err := ports.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ports.ExtractPorts(allPages)
+}
+
+// databaseInstanceSecurityGroups returns security groups applied to the ports of the instance.
+func databaseInstanceSecurityGroups(networkingClient *gophercloud.ServiceClient, instance *instanceResp) ([]string, error) {
+	allPorts, err := databaseInstancePorts(networkingClient, instance, "")
+	if err != nil {
+		return nil, err
+	}
+	var securityGroups []string
+	seen := make(map[string]bool)
+	for _, port := range allPorts {
+		for _, securityGroup := range port.SecurityGroups {
+			if !seen[securityGroup] {
+				seen[securityGroup] = true
+				securityGroups = append(securityGroups, securityGroup)
+			}
+		}
+	}
+	return securityGroups, nil
+}
+
+// databaseInstanceFloatingIPAssociate associates floating IP with the port of the instance in the network.
+// Empty network selects the first port of the instance.
+func databaseInstanceFloatingIPAssociate(client databaseClient, networkingClient *gophercloud.ServiceClient, instanceID, networkID, address string) error {
+	instance, err := instanceGet(client, instanceID).extract()
+	if err != nil {
+		return err
+	}
+
+	fip, err := networkingFloatingIPGetByAddress(networkingClient, address)
+	if err != nil {
+		return err
+	}
+
+	allPorts, err := databaseInstancePorts(networkingClient, instance, networkID)
+	if err != nil {
+		return err
+	}
+	if len(allPorts) == 0 {
+		return fmt.Errorf("no ports found for instance %s", instanceID)
+	}
+
+	return networkingFloatingIPAssociate(networkingClient, fip.ID, allPorts[0].ID, "")
+}
+
+// databaseInstanceFloatingIP returns floating IP with the address if it is associated with
+// a port of the instance. Released floating IP is not associated.
+func databaseInstanceFloatingIP(networkingClient *gophercloud.ServiceClient, instance *instanceResp, address string) (*floatingips.FloatingIP, error) {
+	fip, err := networkingFloatingIPGetByAddress(networkingClient, address)
+	if err == errNetworkingFloatingIPNotAllocated {
+		return nil, nil
+	}
+	if err != nil || fip.PortID == "" {
+		return nil, err
+	}
+
+	allPorts, err := databaseInstancePorts(networkingClient, instance, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, port := range allPorts {
+		if port.ID == fip.PortID {
+			return fip, nil
+		}
+	}
+	return nil, nil
+}
+
 func getDBMSResource(client databaseClient, dbmsID string) (interface{}, error) {
 	instanceResource, err := instanceGet(client, dbmsID).extract()
 	if err == nil {
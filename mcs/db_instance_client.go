@@ -40,6 +40,7 @@ type instanceResp struct {
 	Status            string                  `json:"status"`
 	Volume            *volume                 `json:"volume"`
 	ReplicaOf         *links                  `json:"replica_of"`
+	Metadata          map[string]string       `json:"metadata"`
 }
 
 // volume represents database instance volume
@@ -338,13 +339,15 @@ type dbInstanceCreateOpts struct {
 	MaxDiskSize       int                      `json:"volume_autoresize_max_size,omitempty"`
 	Walvolume         *walVolume               `json:"wal_volume,omitempty"`
 	Capabilities      []instanceCapabilityOpts `json:"capabilities,omitempty"`
+	Metadata          map[string]string        `json:"metadata,omitempty"`
 }
 
 // networkOpts represents network parameters of database instance
 type networkOpts struct {
-	UUID      string `json:"net-id,omitempty"`
-	Port      string `json:"port-id,omitempty"`
-	V4FixedIP string `json:"v4-fixed-ip,omitempty"`
+	UUID           string   `json:"net-id,omitempty"`
+	Port           string   `json:"port-id,omitempty"`
+	V4FixedIP      string   `json:"v4-fixed-ip,omitempty"`
+	SecurityGroups []string `json:"security_groups,omitempty"`
 }
 
 type commonInstanceResult struct {
@@ -504,6 +507,30 @@ func instanceGet(client databaseClient, id string) (r getInstanceResult) {
 	return
 }
 
+// instanceList performs requests to list all database instances of the project, following next page links
+func instanceList(client databaseClient) ([]instanceResp, error) {
+	var allInstances []instanceResp
+	url := baseURL(client, instancesAPIPath)
+	for url != "" {
+		var page struct {
+			Instances []instanceResp `json:"instances"`
+			Links     []link         `json:"links"`
+		}
+		if _, err := client.Get(url, &page, getRequestOpts(200)); err != nil {
+			return nil, err
+		}
+		allInstances = append(allInstances, page.Instances...)
+
+		url = ""
+		for _, l := range page.Links {
+			if l.Rel == "next" && len(page.Instances) > 0 {
+				url = l.Href
+			}
+		}
+	}
+	return allInstances, nil
+}
+
 // instanceDetachReplica performs request to detach replica of database instance
 func instanceDetachReplica(client databaseClient, id string, opts optsBuilder) (r instances.ActionResult) {
 	b, err := opts.Map()
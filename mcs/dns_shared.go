@@ -0,0 +1,91 @@
+package mcs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zones"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	dnsCreateTimeout = 10
+	dnsUpdateTimeout = 10
+	dnsDeleteTimeout = 10
+	dnsDelay         = 5
+	dnsMinTimeout    = 3
+)
+
+const (
+	dnsStatusPending = "PENDING"
+	dnsStatusActive  = "ACTIVE"
+	dnsStatusError   = "ERROR"
+	dnsStatusDeleted = "DELETED"
+)
+
+// dnsFQDN returns the name with the trailing dot the DNS API expects.
+func dnsFQDN(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// suppressDNSTrailingDotDiffs suppresses diff of names differing only in the trailing dot.
+func suppressDNSTrailingDotDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return dnsFQDN(old) == dnsFQDN(new)
+}
+
+func dnsZoneStateRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		z, err := zones.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return z, dnsStatusDeleted, nil
+			}
+			return nil, "", err
+		}
+
+		if z.Status == dnsStatusError {
+			return z, z.Status, fmt.Errorf("there was an error with the zone")
+		}
+
+		return z, z.Status, nil
+	}
+}
+
+func dnsRecordSetStateRefreshFunc(client *gophercloud.ServiceClient, zoneID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rs, err := recordsets.Get(client, zoneID, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return rs, dnsStatusDeleted, nil
+			}
+			return nil, "", err
+		}
+
+		if rs.Status == dnsStatusError {
+			return rs, rs.Status, fmt.Errorf("there was an error with the recordset")
+		}
+
+		return rs, rs.Status, nil
+	}
+}
+
+// waitForDNS waits for changes of the zone or recordset to be propagated to DNS servers.
+func waitForDNS(refresh resource.StateRefreshFunc, pending, target []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    refresh,
+		Timeout:    timeout,
+		Delay:      dnsDelay * time.Second,
+		MinTimeout: dnsMinTimeout * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
@@ -0,0 +1,131 @@
+package mcs
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// imagesFilterSchema returns arguments images are looked up by.
+func imagesFilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"visibility": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(images.ImageVisibilityPublic), string(images.ImageVisibilityPrivate),
+				string(images.ImageVisibilityShared), string(images.ImageVisibilityCommunity),
+			}, false),
+		},
+		"owner": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"tags": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Set:      schema.HashString,
+		},
+		"properties": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// imagesFind returns active images matching the filter arguments, the most recently created ones first.
+// Tags are filtered by the image service, properties are matched by the provider.
+func imagesFind(client *gophercloud.ServiceClient, d *schema.ResourceData) ([]images.Image, error) {
+	listOpts := images.ListOpts{
+		Name:       d.Get("name").(string),
+		Visibility: images.ImageVisibility(d.Get("visibility").(string)),
+		Owner:      d.Get("owner").(string),
+		Status:     images.ImageStatusActive,
+		Tags:       expandToStringSlice(d.Get("tags").(*schema.Set).List()),
+	}
+
+	allPages, err := images.List(client, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	allImages, err := images.ExtractImages(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := expandToMapStringString(d.Get("properties").(map[string]interface{}))
+	matched := make([]images.Image, 0, len(allImages))
+	for _, image := range allImages {
+		if imagePropertiesMatch(image, properties) {
+			matched = append(matched, image)
+		}
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].CreatedAt.After(matched[j].CreatedAt)
+	})
+
+	return matched, nil
+}
+
+func imagePropertiesMatch(image images.Image, properties map[string]string) bool {
+	for k, v := range properties {
+		if p, ok := image.Properties[k]; !ok || fmt.Sprint(p) != v {
+			return false
+		}
+	}
+	return true
+}
+
+// flattenImageProperties returns scalar properties of the image, nested ones can not be represented by a map of strings.
+func flattenImageProperties(image images.Image) map[string]string {
+	properties := make(map[string]string, len(image.Properties))
+	for k, v := range image.Properties {
+		switch v.(type) {
+		case string, bool, float64:
+			properties[k] = fmt.Sprint(v)
+		}
+	}
+	return properties
+}
+
+func flattenImage(image images.Image) map[string]interface{} {
+	return map[string]interface{}{
+		"id":               image.ID,
+		"name":             image.Name,
+		"visibility":       string(image.Visibility),
+		"owner":            image.Owner,
+		"tags":             image.Tags,
+		"properties":       flattenImageProperties(image),
+		"container_format": image.ContainerFormat,
+		"disk_format":      image.DiskFormat,
+		"min_disk_gb":      image.MinDiskGigabytes,
+		"min_ram_mb":       image.MinRAMMegabytes,
+		"size_bytes":       int(image.SizeBytes),
+		"checksum":         image.Checksum,
+		"created_at":       image.CreatedAt.Format(time.RFC3339),
+		"updated_at":       image.UpdatedAt.Format(time.RFC3339),
+	}
+}
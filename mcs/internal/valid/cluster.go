@@ -2,6 +2,7 @@ package valid
 
 import (
 	"errors"
+	"net/url"
 	"strings"
 
 	"github.com/MailRuCloudSolutions/terraform-provider-mcs/mcs/internal/util/textutil"
@@ -10,6 +11,7 @@ import (
 var (
 	ErrInvalidClusterName      = errors.New("invalid cluster name")
 	ErrInvalidAvailabilityZone = errors.New("invalid availability zone")
+	ErrInvalidOIDCIssuerURL    = errors.New("invalid OIDC issuer URL")
 )
 
 // ClusterName validates name of cluster.
@@ -46,3 +48,18 @@ func AvailabilityZone(name string) error {
 	}
 	return nil
 }
+
+// OIDCIssuerURL validates URL of OIDC provider.
+// Value should be an absolute https URL without query and fragment.
+func OIDCIssuerURL(issuerURL string) error {
+	u, err := url.Parse(issuerURL)
+	if err != nil {
+		return ErrInvalidOIDCIssuerURL
+	}
+
+	if u.Scheme != "https" || u.Host == "" || u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return ErrInvalidOIDCIssuerURL
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package valid
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/MailRuCloudSolutions/terraform-provider-mcs/mcs/internal/util/textutil"
+)
+
+var (
+	ErrInvalidLabelKey    = errors.New("invalid label key")
+	ErrInvalidTaintEffect = errors.New("invalid taint effect")
+)
+
+const (
+	labelKeyNameMaxLength   = 63
+	labelKeyPrefixMaxLength = 253
+)
+
+// LabelKey validates key of kubernetes label or taint.
+// Key consists of an optional prefix and a name separated by a slash.
+// Prefix should be a DNS subdomain not longer than 253 characters,
+// name should match the pattern ^[a-zA-Z0-9]([a-zA-Z0-9_.-]*[a-zA-Z0-9])?$
+// and be not longer than 63 characters.
+func LabelKey(key string) error {
+	name := key
+	if i := strings.Index(key, "/"); i >= 0 {
+		if !isDNSSubdomain(key[:i]) {
+			return ErrInvalidLabelKey
+		}
+		name = key[i+1:]
+	}
+
+	if len(name) == 0 || len(name) > labelKeyNameMaxLength {
+		return ErrInvalidLabelKey
+	}
+
+	if !textutil.IsLetterDigitSymbol(rune(name[0])) || !textutil.IsLetterDigitSymbol(rune(name[len(name)-1])) {
+		return ErrInvalidLabelKey
+	}
+
+	for _, r := range name {
+		if !textutil.IsLetterDigitSymbol(r, '_', '.', '-') {
+			return ErrInvalidLabelKey
+		}
+	}
+
+	return nil
+}
+
+// isDNSSubdomain reports whether the value is a lowercase RFC 1123 subdomain.
+func isDNSSubdomain(value string) bool {
+	if len(value) == 0 || len(value) > labelKeyPrefixMaxLength || strings.ToLower(value) != value {
+		return false
+	}
+
+	for _, part := range strings.Split(value, ".") {
+		if len(part) == 0 {
+			return false
+		}
+		if !textutil.IsLetterDigitSymbol(rune(part[0])) || !textutil.IsLetterDigitSymbol(rune(part[len(part)-1])) {
+			return false
+		}
+		for _, r := range part {
+			if !textutil.IsLetterDigitSymbol(r, '-') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+var taintEffects = map[string]struct{}{
+	"NoSchedule":       {},
+	"PreferNoSchedule": {},
+	"NoExecute":        {},
+}
+
+// TaintEffect validates effect of kubernetes taint.
+func TaintEffect(effect string) error {
+	if _, ok := taintEffects[effect]; !ok {
+		return ErrInvalidTaintEffect
+	}
+	return nil
+}
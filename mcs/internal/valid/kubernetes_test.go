@@ -0,0 +1,67 @@
+package valid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLabelKey(t *testing.T) {
+	tests := map[string]struct {
+		key string
+		err error
+	}{
+		// errors
+		"empty key":              {key: "", err: ErrInvalidLabelKey},
+		"empty name":             {key: "example.com/", err: ErrInvalidLabelKey},
+		"empty prefix":           {key: "/name", err: ErrInvalidLabelKey},
+		"invalid first symbol":   {key: "-name", err: ErrInvalidLabelKey},
+		"invalid last symbol":    {key: "name_", err: ErrInvalidLabelKey},
+		"invalid symbol":         {key: "na:me", err: ErrInvalidLabelKey},
+		"too long name":          {key: strings.Repeat("a", 64), err: ErrInvalidLabelKey},
+		"uppercase prefix":       {key: "Example.com/name", err: ErrInvalidLabelKey},
+		"invalid prefix":         {key: "example..com/name", err: ErrInvalidLabelKey},
+		"too long prefix":        {key: strings.Repeat("a", 254) + "/name", err: ErrInvalidLabelKey},
+		"several slashes in key": {key: "example.com/a/name", err: ErrInvalidLabelKey},
+		// ok
+		"one symbol name":    {key: "a", err: nil},
+		"normal name":        {key: "Node-Role_1.x", err: nil},
+		"max length name":    {key: strings.Repeat("a", 63), err: nil},
+		"name with prefix":   {key: "node-role.kubernetes.io/worker", err: nil},
+		"max length prefix":  {key: strings.Repeat("a", 253) + "/name", err: nil},
+		"numeric name start": {key: "1name", err: nil},
+	}
+
+	for name := range tests {
+		tt := tests[name]
+		t.Run(name, func(t *testing.T) {
+			if err := LabelKey(tt.key); err != tt.err {
+				t.Errorf("err got=%s; want=%s", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestTaintEffect(t *testing.T) {
+	tests := map[string]struct {
+		effect string
+		err    error
+	}{
+		// ok
+		"NoSchedule":       {effect: "NoSchedule", err: nil},
+		"PreferNoSchedule": {effect: "PreferNoSchedule", err: nil},
+		"NoExecute":        {effect: "NoExecute", err: nil},
+		// invalid effect
+		"typo":        {effect: "NoScheduel", err: ErrInvalidTaintEffect},
+		"lowercase":   {effect: "noschedule", err: ErrInvalidTaintEffect},
+		"empty value": {effect: "", err: ErrInvalidTaintEffect},
+	}
+
+	for name := range tests {
+		tt := tests[name]
+		t.Run(name, func(t *testing.T) {
+			if err := TaintEffect(tt.effect); err != tt.err {
+				t.Errorf("err got=%s; want=%s", err, tt.err)
+			}
+		})
+	}
+}
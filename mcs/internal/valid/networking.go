@@ -0,0 +1,35 @@
+package valid
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInvalidSecGroupRulePorts = errors.New("invalid security group rule port range")
+)
+
+// SecGroupRulePorts validates port range of security group rule.
+// Port range requires protocol and should be within 1-65535 with min not greater than max.
+// For ICMP min and max are type and code of messages and should be within 0-255.
+// Zero min and max mean all ports.
+func SecGroupRulePorts(protocol string, min, max int) error {
+	if min == 0 && max == 0 {
+		return nil
+	}
+
+	switch strings.ToLower(protocol) {
+	case "":
+		return ErrInvalidSecGroupRulePorts
+	case "icmp", "ipv6-icmp":
+		if min < 0 || min > 255 || max < 0 || max > 255 {
+			return ErrInvalidSecGroupRulePorts
+		}
+	default:
+		if min < 1 || max > 65535 || min > max {
+			return ErrInvalidSecGroupRulePorts
+		}
+	}
+
+	return nil
+}
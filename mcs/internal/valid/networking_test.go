@@ -0,0 +1,32 @@
+package valid
+
+import "testing"
+
+func TestSecGroupRulePorts(t *testing.T) {
+	tests := map[string]struct {
+		protocol string
+		min, max int
+		err      error
+	}{
+		// ok
+		"all ports":   {protocol: "", min: 0, max: 0, err: nil},
+		"single port": {protocol: "tcp", min: 5432, max: 5432, err: nil},
+		"port range":  {protocol: "udp", min: 1024, max: 65535, err: nil},
+		"icmp echo":   {protocol: "icmp", min: 8, max: 0, err: nil},
+		// errors
+		"no protocol":    {protocol: "", min: 22, max: 22, err: ErrInvalidSecGroupRulePorts},
+		"reversed range": {protocol: "tcp", min: 443, max: 80, err: ErrInvalidSecGroupRulePorts},
+		"no min":         {protocol: "tcp", min: 0, max: 80, err: ErrInvalidSecGroupRulePorts},
+		"too big port":   {protocol: "tcp", min: 80, max: 65536, err: ErrInvalidSecGroupRulePorts},
+		"icmp code":      {protocol: "icmp", min: 3, max: 256, err: ErrInvalidSecGroupRulePorts},
+	}
+
+	for name := range tests {
+		tt := tests[name]
+		t.Run(name, func(t *testing.T) {
+			if err := SecGroupRulePorts(tt.protocol, tt.min, tt.max); err != tt.err {
+				t.Errorf("err got=%s; want=%s", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package mcs
+
+import (
+	"net/http"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+const (
+	addonsAPIPath          = "addons"
+	availableAddonsAPIPath = "available_addons"
+)
+
+// addon represents addon installed to a cluster.
+type addon struct {
+	ID        string `json:"id"`
+	ClusterID string `json:"cluster_id"`
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	Namespace string `json:"namespace"`
+	Values    string `json:"values"`
+	Status    string `json:"status"`
+}
+
+// availableAddon represents addon that can be installed to a cluster.
+type availableAddon struct {
+	Name          string   `json:"name"`
+	Versions      []string `json:"versions"`
+	Description   string   `json:"description"`
+	DefaultValues string   `json:"default_values"`
+}
+
+type addons struct {
+	Addons []addon `json:"addons"`
+}
+
+type availableAddons struct {
+	Addons []availableAddon `json:"addons"`
+}
+
+// addonInstallOpts contains options to install addon to a cluster.
+type addonInstallOpts struct {
+	Name      string `json:"name" required:"true"`
+	Version   string `json:"version" required:"true"`
+	Namespace string `json:"namespace,omitempty"`
+	Values    string `json:"values,omitempty"`
+}
+
+// addonUpgradeOpts contains options to upgrade addon installed to a cluster.
+type addonUpgradeOpts struct {
+	Version string `json:"version,omitempty"`
+	Values  string `json:"values"`
+}
+
+// Map builds request params.
+func (opts *addonInstallOpts) Map() (map[string]interface{}, error) {
+	body, err := gophercloud.BuildRequestBody(*opts, "")
+	return body, err
+}
+
+// Map builds request params.
+func (opts *addonUpgradeOpts) Map() (map[string]interface{}, error) {
+	body, err := gophercloud.BuildRequestBody(*opts, "")
+	return body, err
+}
+
+type addonResult struct {
+	commonResult
+}
+
+type addonsResult struct {
+	commonResult
+}
+
+type availableAddonsResult struct {
+	commonResult
+}
+
+type addonDeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Extract parses result into params for addon.
+func (r addonResult) Extract() (*addon, error) {
+	var s *addon
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// Extract parses result into params for addons.
+func (r addonsResult) Extract() ([]addon, error) {
+	var s *addons
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+	return s.Addons, nil
+}
+
+// Extract parses result into params for available addons.
+func (r availableAddonsResult) Extract() ([]availableAddon, error) {
+	var s *availableAddons
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+	return s.Addons, nil
+}
+
+func addonInstall(client ContainerClient, clusterID string, opts optsBuilder) (r addonResult) {
+	b, err := opts.Map()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	var result *http.Response
+	reqOpts := getRequestOpts(202)
+	result, r.Err = client.Post(addonsURL(client, clustersAPIPath, clusterID), b, &r.Body, reqOpts)
+	if r.Err == nil {
+		r.Header = result.Header
+	}
+	return
+}
+
+func addonGet(client ContainerClient, clusterID, id string) (r addonResult) {
+	var result *http.Response
+	reqOpts := getRequestOpts(200)
+	result, r.Err = client.Get(addonURL(client, clustersAPIPath, clusterID, id), &r.Body, reqOpts)
+	if r.Err == nil {
+		r.Header = result.Header
+	}
+	return
+}
+
+func addonList(client ContainerClient, clusterID string) (r addonsResult) {
+	var result *http.Response
+	reqOpts := getRequestOpts(200)
+	result, r.Err = client.Get(addonsURL(client, clustersAPIPath, clusterID), &r.Body, reqOpts)
+	if r.Err == nil {
+		r.Header = result.Header
+	}
+	return
+}
+
+func availableAddonList(client ContainerClient, clusterID string) (r availableAddonsResult) {
+	var result *http.Response
+	reqOpts := getRequestOpts(200)
+	result, r.Err = client.Get(availableAddonsURL(client, clustersAPIPath, clusterID), &r.Body, reqOpts)
+	if r.Err == nil {
+		r.Header = result.Header
+	}
+	return
+}
+
+func addonUpgrade(client ContainerClient, clusterID, id string, opts optsBuilder) (r addonResult) {
+	b, err := opts.Map()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	var result *http.Response
+	reqOpts := getRequestOpts(200, 202)
+	result, r.Err = client.Patch(addonURL(client, clustersAPIPath, clusterID, id), b, &r.Body, reqOpts)
+	if r.Err == nil {
+		r.Header = result.Header
+	}
+	return
+}
+
+func addonDelete(client ContainerClient, clusterID, id string) (r addonDeleteResult) {
+	var result *http.Response
+	reqOpts := getRequestOpts(202, 204)
+	result, r.Err = client.Delete(addonURL(client, clustersAPIPath, clusterID, id), reqOpts)
+	if r.Err == nil {
+		r.Header = result.Header
+	}
+	return
+}
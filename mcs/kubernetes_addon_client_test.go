@@ -0,0 +1,88 @@
+package mcs
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddonInstall(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/clusters/cluster/addons", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"name": "ingress-nginx", "version": "3.1.0", "values": "replicaCount: 2"}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, `{"id": "addon", "cluster_id": "cluster", "name": "ingress-nginx", "version": "3.1.0"}`)
+	})
+
+	opts := addonInstallOpts{
+		Name:    "ingress-nginx",
+		Version: "3.1.0",
+		Values:  "replicaCount: 2",
+	}
+	a, err := addonInstall(fake.ServiceClient(), "cluster", &opts).Extract()
+	assert.NoError(t, err)
+	assert.Equal(t, "addon", a.ID)
+	assert.Equal(t, "cluster", a.ClusterID)
+}
+
+func TestAddonList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/clusters/cluster/addons", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"addons": [{"id": "addon", "name": "ingress-nginx", "status": "INSTALLED"}]}`)
+	})
+	th.Mux.HandleFunc("/clusters/cluster/available_addons", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"addons": [{"name": "cert-manager", "versions": ["1.1.0", "1.2.0"]}]}`)
+	})
+
+	installed, err := addonList(fake.ServiceClient(), "cluster").Extract()
+	assert.NoError(t, err)
+	assert.Len(t, installed, 1)
+	assert.Equal(t, "INSTALLED", installed[0].Status)
+
+	available, err := availableAddonList(fake.ServiceClient(), "cluster").Extract()
+	assert.NoError(t, err)
+	assert.Len(t, available, 1)
+	assert.Equal(t, []string{"1.1.0", "1.2.0"}, available[0].Versions)
+}
+
+func TestAddonDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/clusters/cluster/addons/addon", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	assert.NoError(t, addonDelete(fake.ServiceClient(), "cluster", "addon").ExtractErr())
+}
+
+func TestSuppressEquivalentYAMLDiffs(t *testing.T) {
+	assert.True(t, suppressEquivalentYAMLDiffs("values", "a: 1\nb: [1, 2]\n", "b:\n  - 1\n  - 2\na: 1", nil))
+	assert.False(t, suppressEquivalentYAMLDiffs("values", "a: 1", "a: 2", nil))
+	assert.False(t, suppressEquivalentYAMLDiffs("values", "a: 1", "a: [", nil))
+}
@@ -38,10 +38,26 @@ type node struct {
 	Name        string     `json:"name"`
 	UUID        string     `json:"uuid"`
 	NodeGroupID string     `json:"node_group_id"`
+	Status      nodeStatus `json:"status,omitempty"`
 	CreatedAt   *time.Time `json:"created_at"`
 	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
 }
 
+type nodeStatus string
+
+var nodeStatusReady nodeStatus = "Ready"
+
+// countHealthyNodes returns amount of nodes reported as Ready.
+func countHealthyNodes(nodes []*node) int {
+	var count int
+	for _, node := range nodes {
+		if node.Status == nodeStatusReady {
+			count++
+		}
+	}
+	return count
+}
+
 type nodesFlatSchema []map[string]interface{}
 
 func flattenNodes(nodes []*node) nodesFlatSchema {
@@ -51,6 +67,7 @@ func flattenNodes(nodes []*node) nodesFlatSchema {
 			"name":          node.Name,
 			"uuid":          node.UUID,
 			"node_group_id": node.NodeGroupID,
+			"status":        string(node.Status),
 			"created_at":    getTimestamp(node.CreatedAt),
 			"updated_at":    getTimestamp(node.UpdatedAt),
 		})
@@ -72,22 +89,26 @@ type nodeGroupBatchAddParams struct {
 }
 
 type nodeGroup struct {
-	Name              string    `json:"name,omitempty"`
-	NodeCount         int       `json:"node_count,omitempty"`
-	MaxNodes          int       `json:"max_nodes,omitempty"`
-	MinNodes          int       `json:"min_nodes,omitempty"`
-	VolumeSize        int       `json:"volume_size,omitempty"`
-	VolumeType        string    `json:"volume_type,omitempty"`
-	FlavorID          string    `json:"flavor_id,omitempty"`
-	ImageID           string    `json:"image_id,omitempty"`
-	Autoscaling       bool      `json:"autoscaling_enabled,omitempty"`
-	ClusterID         string    `json:"cluster_id,omitempty"`
-	UUID              string    `json:"uuid,omitempty"`
-	CreatedAt         time.Time `json:"created_at,omitempty"`
-	UpdatedAt         time.Time `json:"updated_at,omitempty"`
-	Nodes             []*node   `json:"nodes,omitempty"`
-	State             string    `json:"state,omitempty"`
-	AvailabilityZones []string  `json:"availability_zones"`
+	Name              string            `json:"name,omitempty"`
+	NodeCount         int               `json:"node_count,omitempty"`
+	MaxNodes          int               `json:"max_nodes,omitempty"`
+	MinNodes          int               `json:"min_nodes,omitempty"`
+	VolumeSize        int               `json:"volume_size,omitempty"`
+	VolumeType        string            `json:"volume_type,omitempty"`
+	FlavorID          string            `json:"flavor_id,omitempty"`
+	ImageID           string            `json:"image_id,omitempty"`
+	ClusterTemplateID string            `json:"cluster_template_id,omitempty"`
+	Autoscaling       bool              `json:"autoscaling_enabled,omitempty"`
+	AutoRepair        bool              `json:"auto_repair,omitempty"`
+	ClusterID         string            `json:"cluster_id,omitempty"`
+	UUID              string            `json:"uuid,omitempty"`
+	CreatedAt         time.Time         `json:"created_at,omitempty"`
+	UpdatedAt         time.Time         `json:"updated_at,omitempty"`
+	Nodes             []*node           `json:"nodes,omitempty"`
+	State             string            `json:"state,omitempty"`
+	AvailabilityZones []string          `json:"availability_zones"`
+	SecurityGroups    []string          `json:"security_groups,omitempty"`
+	Tags              map[string]string `json:"tags,omitempty"`
 }
 
 type nodeGroupLabel struct {
@@ -103,18 +124,23 @@ type nodeGroupTaint struct {
 
 // nodeGroupCreateOpts contains options to create node group.
 type nodeGroupCreateOpts struct {
-	ClusterID         string           `json:"cluster_id" required:"true"`
-	Name              string           `json:"name"`
-	Labels            []nodeGroupLabel `json:"labels,omitempty"`
-	Taints            []nodeGroupTaint `json:"taints,omitempty"`
-	NodeCount         int              `json:"node_count,omitempty"`
-	MaxNodes          int              `json:"max_nodes,omitempty"`
-	MinNodes          int              `json:"min_nodes,omitempty"`
-	VolumeSize        int              `json:"volume_size,omitempty"`
-	VolumeType        string           `json:"volume_type,omitempty"`
-	FlavorID          string           `json:"flavor_id,omitempty"`
-	Autoscaling       bool             `json:"autoscaling_enabled,omitempty"`
-	AvailabilityZones []string         `json:"availability_zones,omitempty"`
+	ClusterID         string            `json:"cluster_id" required:"true"`
+	Name              string            `json:"name"`
+	Labels            []nodeGroupLabel  `json:"labels,omitempty"`
+	Taints            []nodeGroupTaint  `json:"taints,omitempty"`
+	NodeCount         int               `json:"node_count,omitempty"`
+	MaxNodes          int               `json:"max_nodes,omitempty"`
+	MinNodes          int               `json:"min_nodes,omitempty"`
+	VolumeSize        int               `json:"volume_size,omitempty"`
+	VolumeType        string            `json:"volume_type,omitempty"`
+	FlavorID          string            `json:"flavor_id,omitempty"`
+	ImageID           string            `json:"image_id,omitempty"`
+	ClusterTemplateID string            `json:"cluster_template_id,omitempty"`
+	Autoscaling       bool              `json:"autoscaling_enabled,omitempty"`
+	AutoRepair        bool              `json:"auto_repair,omitempty"`
+	AvailabilityZones []string          `json:"availability_zones,omitempty"`
+	SecurityGroups    []string          `json:"security_groups,omitempty"`
+	Tags              map[string]string `json:"tags,omitempty"`
 }
 
 // nodeGroupScaleOpts contains options to scale node group
@@ -123,6 +149,13 @@ type nodeGroupScaleOpts struct {
 	Rollback string `json:"rollback,omitempty"`
 }
 
+// nodeGroupUpgradeOpts contains options to upgrade node group
+type nodeGroupUpgradeOpts struct {
+	ClusterTemplateID string `json:"cluster_template_id,omitempty"`
+	ImageID           string `json:"image_id,omitempty"`
+	RollingEnabled    bool   `json:"rolling_enabled"`
+}
+
 // clusterCreateOpts contains options to create cluster
 type clusterCreateOpts struct {
 	ClusterTemplateID    string            `json:"cluster_template_id" required:"true"`
@@ -140,6 +173,23 @@ type clusterCreateOpts struct {
 	RegistryAuthPassword string            `json:"registry_auth_password,omitempty"`
 	AvailabilityZone     string            `json:"availability_zone,omitempty"`
 	LoadbalancerSubnetID string            `json:"loadbalancer_subnet_id,omitempty"`
+	OIDC                 *clusterOIDC      `json:"oidc,omitempty"`
+	APIAccess            *clusterAPIAccess `json:"api_access,omitempty"`
+	Tags                 map[string]string `json:"tags,omitempty"`
+}
+
+// clusterAPIAccess contains restrictions of access to the cluster API load balancer.
+type clusterAPIAccess struct {
+	AllowedCIDRs []string `json:"allowed_cidrs"`
+}
+
+// clusterOIDC contains settings of kube-apiserver authentication via OIDC provider.
+type clusterOIDC struct {
+	IssuerURL     string `json:"issuer_url" required:"true"`
+	ClientID      string `json:"client_id" required:"true"`
+	UsernameClaim string `json:"username_claim,omitempty"`
+	GroupsClaim   string `json:"groups_claim,omitempty"`
+	CA            string `json:"ca,omitempty"`
 }
 
 type clusterActionsBaseOpts struct {
@@ -184,6 +234,13 @@ type cluster struct {
 	RegistryAuthPassword string             `json:"registry_auth_password"`
 	AvailabilityZone     string             `json:"availability_zone"`
 	LoadbalancerSubnetID string             `json:"loadbalancer_subnet_id"`
+	OIDC                 *clusterOIDC       `json:"oidc"`
+	APIAccess            *clusterAPIAccess  `json:"api_access"`
+	Tags                 map[string]string  `json:"tags"`
+}
+
+type clusterListResponse struct {
+	Clusters []cluster `json:"clusters"`
 }
 
 type clusterTemplate struct {
@@ -233,6 +290,12 @@ func (opts *nodeGroupScaleOpts) Map() (map[string]interface{}, error) {
 	return body, err
 }
 
+// Map builds request params.
+func (opts *nodeGroupUpgradeOpts) Map() (map[string]interface{}, error) {
+	body, err := gophercloud.BuildRequestBody(*opts, "")
+	return body, err
+}
+
 // Map builds request params.
 func (opts *clusterUpgradeOpts) Map() (map[string]interface{}, error) {
 	body, err := gophercloud.BuildRequestBody(*opts, "")
@@ -314,6 +377,20 @@ func (r clusterConfigResult) Extract() (*cluster, error) {
 	return s, err
 }
 
+type clustersResult struct {
+	commonResult
+}
+
+// Extract parses result into params for clusters.
+func (r clustersResult) Extract() ([]cluster, error) {
+	var s *clusterListResponse
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+	return s.Clusters, nil
+}
+
 // Extract parses result into params for cluster template.
 func (r clusterTemplateResult) Extract() (*clusterTemplate, error) {
 	var s *clusterTemplate
@@ -432,6 +509,17 @@ func clusterGet(client ContainerClient, id string) (r clusterConfigResult) {
 	return
 }
 
+// clusterList gets list of clusters from mcs.
+func clusterList(client ContainerClient) (r clustersResult) {
+	var result *http.Response
+	reqOpts := getRequestOpts(200)
+	result, r.Err = client.Get(baseURL(client, clustersAPIPath), &r.Body, reqOpts)
+	if r.Err == nil {
+		r.Header = result.Header
+	}
+	return
+}
+
 func clusterDelete(client ContainerClient, id string) (r clusterDeleteResult) {
 	var result *http.Response
 	reqOpts := getRequestOpts()
@@ -465,6 +553,21 @@ func nodeGroupScale(client ContainerClient, id string, opts optsBuilder) (r node
 	return
 }
 
+func nodeGroupUpgrade(client ContainerClient, id string, opts optsBuilder) (r nodeGroupResult) {
+	b, err := opts.Map()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	reqOpts := getRequestOpts(200, 202)
+	var result *http.Response
+	result, r.Err = client.Patch(upgradeURL(client, nodeGroupsAPIPath, id), b, &r.Body, reqOpts)
+	if r.Err == nil {
+		r.Header = result.Header
+	}
+	return
+}
+
 func nodeGroupCreate(client ContainerClient, opts optsBuilder) (r nodeGroupResult) {
 	b, err := opts.Map()
 	if err != nil {
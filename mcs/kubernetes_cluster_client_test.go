@@ -133,3 +133,15 @@ func TestK8sConfigGetError(t *testing.T) {
 	_, err := k8sConfigGet(serviceClient, "notfound")
 	assert.Error(t, err)
 }
+
+func TestCountHealthyNodes(t *testing.T) {
+	nodes := []*node{
+		{Name: "node-1", Status: nodeStatusReady},
+		{Name: "node-2", Status: "NotReady"},
+		{Name: "node-3", Status: nodeStatusReady},
+		{Name: "node-4"},
+	}
+
+	assert.Equal(t, 2, countHealthyNodes(nodes))
+	assert.Equal(t, 0, countHealthyNodes(nil))
+}
@@ -2,10 +2,18 @@ package mcs
 
 import (
 	"fmt"
+	"log"
+	"strconv"
+	"strings"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/mitchellh/mapstructure"
+
+	"github.com/MailRuCloudSolutions/terraform-provider-mcs/mcs/internal/valid"
 )
 
 func extractKubernetesGroupMap(nodeGroups []interface{}) ([]nodeGroup, error) {
@@ -39,6 +47,172 @@ func extractKubernetesLabelsMap(v map[string]interface{}) (map[string]string, er
 	return m, nil
 }
 
+// Labels of cluster features exposed as typed arguments of mcs_kubernetes_cluster.
+const (
+	ingressControllerLabel = "ingress_controller"
+	monitoringEnabledLabel = "prometheus_monitoring"
+	calicoIPIPModeLabel    = "calico_ipv4pool_ipip"
+	registryMirrorsLabel   = "registry_mirrors"
+)
+
+var (
+	ingressControllers = []string{"nginx", "octavia"}
+	calicoIPIPModes    = []string{"Always", "CrossSubnet", "Never"}
+)
+
+// flattenKubernetesClusterLabels returns labels of the cluster except the ones the api adds:
+// labels of typed features and labels inherited from the cluster template with the template value.
+// Labels known to the state are always returned, so changes of their values are detected.
+func flattenKubernetesClusterLabels(stateLabels map[string]interface{}, labels, templateLabels map[string]string) map[string]string {
+	m := make(map[string]string, len(labels))
+	for key, value := range labels {
+		if _, ok := stateLabels[key]; !ok {
+			if templateValue, ok := templateLabels[key]; ok && templateValue == value {
+				continue
+			}
+			switch key {
+			case ingressControllerLabel, monitoringEnabledLabel, calicoIPIPModeLabel, registryMirrorsLabel:
+				continue
+			}
+		}
+		m[key] = value
+	}
+	return m
+}
+
+// expandKubernetesClusterFeatureLabels translates typed feature arguments into cluster labels.
+func expandKubernetesClusterFeatureLabels(d *schema.ResourceData) map[string]string {
+	labels := make(map[string]string)
+	if v, ok := d.GetOk("ingress"); ok {
+		ingress := v.([]interface{})[0].(map[string]interface{})
+		labels[ingressControllerLabel] = ingress["controller"].(string)
+	}
+	if v, ok := d.GetOk("monitoring"); ok {
+		monitoring := v.([]interface{})[0].(map[string]interface{})
+		labels[monitoringEnabledLabel] = strconv.FormatBool(monitoring["enabled"].(bool))
+	}
+	if v, ok := d.GetOk("network_policy"); ok {
+		networkPolicy := v.([]interface{})[0].(map[string]interface{})
+		labels[calicoIPIPModeLabel] = networkPolicy["calico_ipip_mode"].(string)
+	}
+	if v, ok := d.GetOk("registry_mirrors"); ok {
+		rawMirrors := v.([]interface{})
+		mirrors := make([]string, 0, len(rawMirrors))
+		for _, mirror := range rawMirrors {
+			mirrors = append(mirrors, mirror.(string))
+		}
+		labels[registryMirrorsLabel] = strings.Join(mirrors, ",")
+	}
+	return labels
+}
+
+// flattenKubernetesClusterFeatures translates cluster labels into typed feature attributes.
+func flattenKubernetesClusterFeatures(labels map[string]string) map[string]interface{} {
+	features := map[string]interface{}{
+		"ingress":          []map[string]interface{}{},
+		"monitoring":       []map[string]interface{}{},
+		"network_policy":   []map[string]interface{}{},
+		"registry_mirrors": []string{},
+	}
+	if controller, ok := labels[ingressControllerLabel]; ok && controller != "" {
+		features["ingress"] = []map[string]interface{}{{"controller": controller}}
+	}
+	if enabled, err := strconv.ParseBool(labels[monitoringEnabledLabel]); err == nil {
+		features["monitoring"] = []map[string]interface{}{{"enabled": enabled}}
+	}
+	if mode, ok := labels[calicoIPIPModeLabel]; ok && mode != "" {
+		features["network_policy"] = []map[string]interface{}{{"calico_ipip_mode": mode}}
+	}
+	if mirrors, ok := labels[registryMirrorsLabel]; ok && mirrors != "" {
+		features["registry_mirrors"] = strings.Split(mirrors, ",")
+	}
+	return features
+}
+
+func extractKubernetesClusterOIDC(v []interface{}) (*clusterOIDC, error) {
+	if len(v) == 0 || v[0] == nil {
+		return nil, nil
+	}
+	var oidc clusterOIDC
+	in := v[0].(map[string]interface{})
+	err := mapStructureDecoder(&oidc, &in, decoderConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &oidc, nil
+}
+
+func flattenKubernetesClusterOIDC(oidc *clusterOIDC) []map[string]interface{} {
+	if oidc == nil {
+		return nil
+	}
+	return []map[string]interface{}{{
+		"issuer_url":     oidc.IssuerURL,
+		"client_id":      oidc.ClientID,
+		"username_claim": oidc.UsernameClaim,
+		"groups_claim":   oidc.GroupsClaim,
+		"ca":             oidc.CA,
+	}}
+}
+
+func extractKubernetesClusterAPIAccess(v []interface{}) *clusterAPIAccess {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	in := v[0].(map[string]interface{})
+	rawCIDRs := in["allowed_cidrs"].([]interface{})
+	apiAccess := clusterAPIAccess{AllowedCIDRs: make([]string, len(rawCIDRs))}
+	for i, cidr := range rawCIDRs {
+		apiAccess.AllowedCIDRs[i] = cidr.(string)
+	}
+	return &apiAccess
+}
+
+func flattenKubernetesClusterAPIAccess(apiAccess *clusterAPIAccess) []map[string]interface{} {
+	if apiAccess == nil || len(apiAccess.AllowedCIDRs) == 0 {
+		return nil
+	}
+	return []map[string]interface{}{{
+		"allowed_cidrs": apiAccess.AllowedCIDRs,
+	}}
+}
+
+// checkKubernetesClusterPrivateAccess checks that API load balancer settings are consistent
+// for the cluster without floating IP.
+func checkKubernetesClusterPrivateAccess(floatingIPEnabled bool, apiLBVIP, apiLBFIP, loadbalancerSubnetID string) error {
+	if floatingIPEnabled {
+		return nil
+	}
+	if apiLBFIP != "" {
+		return fmt.Errorf("api_lb_fip can't be set when floating_ip_enabled is false")
+	}
+	if apiLBVIP != "" && loadbalancerSubnetID == "" {
+		return fmt.Errorf("loadbalancer_subnet_id must be set along with api_lb_vip when floating_ip_enabled is false")
+	}
+	return nil
+}
+
+// customizeDiffKubernetesClusterPrivateAccess validates on plan that API load balancer settings are consistent.
+// Unset optional attributes are unknown on plan just as the ones interpolated from other resources,
+// so an unknown address is taken as not set and an unknown subnet as set. Create repeats the check.
+func customizeDiffKubernetesClusterPrivateAccess(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" || !d.NewValueKnown("floating_ip_enabled") {
+		return nil
+	}
+	value := func(key, unknown string) string {
+		if !d.NewValueKnown(key) {
+			return unknown
+		}
+		return d.Get(key).(string)
+	}
+	return checkKubernetesClusterPrivateAccess(
+		d.Get("floating_ip_enabled").(bool),
+		value("api_lb_vip", ""),
+		value("api_lb_fip", ""),
+		value("loadbalancer_subnet_id", "unknown"),
+	)
+}
+
 func extractNodeGroupLabelsList(v []interface{}) ([]nodeGroupLabel, error) {
 	labels := make([]nodeGroupLabel, len(v))
 	for i, label := range v {
@@ -65,6 +239,24 @@ func extractNodeGroupTaintsList(v []interface{}) ([]nodeGroupTaint, error) {
 	return taints, nil
 }
 
+// validateKubernetesLabelKey checks that node group label or taint key follows kubernetes label syntax.
+func validateKubernetesLabelKey(val interface{}, key string) (warns []string, errs []error) {
+	labelKey := val.(string)
+	if err := valid.LabelKey(labelKey); err != nil {
+		errs = append(errs, fmt.Errorf("%s: %s", err, labelKey))
+	}
+	return
+}
+
+// validateKubernetesVersion checks that node group kubernetes version is an exact version,
+// so that it matches the version read back from the cluster template.
+func validateKubernetesVersion(val interface{}, key string) (warns []string, errs []error) {
+	if _, err := version.NewVersion(val.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q must be an exact kubernetes version: %s", key, err))
+	}
+	return
+}
+
 func flattenNodeGroupLabelsList(v []nodeGroupLabel) []map[string]interface{} {
 	labels := make([]map[string]interface{}, len(v))
 	for i, label := range v {
@@ -83,6 +275,206 @@ func flattenNodeGroupTaintsList(v []nodeGroupTaint) []map[string]interface{} {
 	return taints
 }
 
+// checkNodeGroupImage ensures that image exists and can be used to boot node group nodes.
+func checkNodeGroupImage(client *gophercloud.ServiceClient, imageID string) error {
+	image, err := images.Get(client, imageID).Extract()
+	if err != nil {
+		return fmt.Errorf("unable to retrieve image %s: %s", imageID, err)
+	}
+	if image.Status != images.ImageStatusActive {
+		return fmt.Errorf("image %s is not active: %s", imageID, image.Status)
+	}
+	return nil
+}
+
+// checkNodeGroupVersion ensures that node group upgraded to the cluster template
+// won't run ahead of kubernetes version of the cluster masters.
+func checkNodeGroupVersion(client ContainerClient, clusterID, clusterTemplateID string) error {
+	c, err := clusterGet(client, clusterID).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving mcs_kubernetes_cluster %s: %s", clusterID, err)
+	}
+
+	mastersTemplate, err := clusterTemplateGet(client, c.ClusterTemplateID).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving cluster template %s: %s", c.ClusterTemplateID, err)
+	}
+	mastersVersion, err := version.NewVersion(mastersTemplate.Version)
+	if err != nil {
+		return fmt.Errorf("unable to parse version of cluster template %s: %s", c.ClusterTemplateID, err)
+	}
+
+	nodeGroupTemplate, err := clusterTemplateGet(client, clusterTemplateID).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving cluster template %s: %s", clusterTemplateID, err)
+	}
+	nodeGroupVersion, err := version.NewVersion(nodeGroupTemplate.Version)
+	if err != nil {
+		return fmt.Errorf("unable to parse version of cluster template %s: %s", clusterTemplateID, err)
+	}
+
+	if nodeGroupVersion.GreaterThan(mastersVersion) {
+		return fmt.Errorf("node group version %s can't be greater than cluster masters version %s, upgrade the cluster first",
+			nodeGroupVersion, mastersVersion)
+	}
+	return nil
+}
+
+// nodeGroupClusterTemplateID returns the UUID of the cluster template with the kubernetes
+// version and the distro and network driver of the cluster masters template.
+func nodeGroupClusterTemplateID(client ContainerClient, clusterID, kubernetesVersion string) (string, error) {
+	c, err := clusterGet(client, clusterID).Extract()
+	if err != nil {
+		return "", fmt.Errorf("error retrieving mcs_kubernetes_cluster %s: %s", clusterID, err)
+	}
+
+	mastersTemplate, err := clusterTemplateGet(client, c.ClusterTemplateID).Extract()
+	if err != nil {
+		return "", fmt.Errorf("error retrieving cluster template %s: %s", c.ClusterTemplateID, err)
+	}
+
+	templates, err := clusterTemplateList(client).Extract()
+	if err != nil {
+		return "", fmt.Errorf("error retrieving cluster templates: %s", err)
+	}
+	template, err := selectClusterTemplate(templates, clusterTemplateFilter{
+		Version:       kubernetesVersion,
+		ClusterDistro: mastersTemplate.ClusterDistro,
+		NetworkDriver: mastersTemplate.NetworkDriver,
+	})
+	if err != nil {
+		return "", err
+	}
+	return template.UUID, nil
+}
+
+// Health of the node group reported by kubernetesNodeGroupHealthRefreshFunc.
+const (
+	nodeGroupHealthy   = "HEALTHY"
+	nodeGroupUnhealthy = "UNHEALTHY"
+)
+
+// kubernetesNodeGroupHealthRefreshFunc reports the node group unhealthy while fewer than
+// node_count of its nodes are Ready.
+func kubernetesNodeGroupHealthRefreshFunc(client ContainerClient, nodeGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ng, err := nodeGroupGet(client, nodeGroupID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+		if countHealthyNodes(ng.Nodes) < ng.NodeCount {
+			return ng, nodeGroupUnhealthy, nil
+		}
+		return ng, nodeGroupHealthy, nil
+	}
+}
+
+// customizeDiffNodeGroupHealthy recreates the node group flagged unhealthy on read,
+// unless auto_repair is enabled and the api repairs the nodes in place.
+func customizeDiffNodeGroupHealthy(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("healthy") || !d.Get("healthy").(bool) || d.Get("auto_repair").(bool) {
+		return nil
+	}
+	return d.ForceNew("healthy")
+}
+
+// customizeDiffNodeGroupVersion checks on plan that the node group won't run ahead of the
+// cluster masters with the changed cluster_template_id or kubernetes_version.
+func customizeDiffNodeGroupVersion(d *schema.ResourceDiff, meta interface{}) error {
+	var clusterTemplateID, kubernetesVersion string
+	if d.HasChange("cluster_template_id") && d.NewValueKnown("cluster_template_id") {
+		clusterTemplateID = d.Get("cluster_template_id").(string)
+	}
+	if d.HasChange("kubernetes_version") && d.NewValueKnown("kubernetes_version") {
+		kubernetesVersion = d.Get("kubernetes_version").(string)
+	}
+	if clusterTemplateID == "" && kubernetesVersion == "" {
+		return nil
+	}
+
+	// Both attributes describe the same template, the one not set is known after apply.
+	if d.Id() != "" {
+		computedKey := "cluster_template_id"
+		if clusterTemplateID != "" {
+			computedKey = "kubernetes_version"
+		}
+		if err := d.SetNewComputed(computedKey); err != nil {
+			return err
+		}
+	}
+
+	if !d.NewValueKnown("cluster_id") {
+		return nil
+	}
+	clusterID := d.Get("cluster_id").(string)
+
+	config := meta.(configer)
+	client, err := config.ContainerInfraV1Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating OpenStack container infra client: %s", err)
+	}
+
+	if kubernetesVersion != "" {
+		clusterTemplateID, err = nodeGroupClusterTemplateID(client, clusterID, kubernetesVersion)
+		if err != nil {
+			return err
+		}
+	}
+	return checkNodeGroupVersion(client, clusterID, clusterTemplateID)
+}
+
+const clusterTemplateLatestVersion = "latest"
+
+// clusterTemplateFilter contains conditions to select cluster template by.
+type clusterTemplateFilter struct {
+	// Version is either exact version, version constraint (e.g. "~> 1.21") or "latest".
+	Version       string
+	ClusterDistro string
+	NetworkDriver string
+}
+
+// selectClusterTemplate returns the template with the greatest kubernetes version among
+// the templates satisfying the filter.
+func selectClusterTemplate(templates []clusterTemplate, filter clusterTemplateFilter) (*clusterTemplate, error) {
+	var constraints version.Constraints
+	if filter.Version != "" && filter.Version != clusterTemplateLatestVersion {
+		var err error
+		constraints, err = version.NewConstraint(filter.Version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cluster template version constraint %q: %s", filter.Version, err)
+		}
+	}
+
+	var found *clusterTemplate
+	var foundVersion *version.Version
+	for i := range templates {
+		t := &templates[i]
+		if filter.ClusterDistro != "" && !strings.EqualFold(filter.ClusterDistro, t.ClusterDistro) {
+			continue
+		}
+		if filter.NetworkDriver != "" && !strings.EqualFold(filter.NetworkDriver, t.NetworkDriver) {
+			continue
+		}
+		v, err := version.NewVersion(t.Version)
+		if err != nil {
+			log.Printf("[DEBUG] Skipping cluster template %s with invalid version %q: %s", t.UUID, t.Version, err)
+			continue
+		}
+		if constraints != nil && !constraints.Check(v) {
+			continue
+		}
+		if found == nil || v.GreaterThan(foundVersion) {
+			found, foundVersion = t, v
+		}
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("no cluster template matches version %q, cluster_distro %q, network_driver %q",
+			filter.Version, filter.ClusterDistro, filter.NetworkDriver)
+	}
+	return found, nil
+}
+
 func kubernetesStateRefreshFunc(client ContainerClient, clusterID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		c, err := clusterGet(client, clusterID).Extract()
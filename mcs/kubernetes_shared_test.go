@@ -1,9 +1,16 @@
 package mcs
 
 import (
+	"fmt"
+	"net/http"
 	"sort"
+	"strconv"
 	"testing"
 
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -128,3 +135,417 @@ func TestExtractNodeGroupTaintsList(t *testing.T) {
 	assert.Equal(t, err, nil)
 	assert.Equal(t, expectedTaints, actualTaints)
 }
+
+func imageGetFixture(t *testing.T, id, status string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/images/%s", id), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"id": "%s", "name": "worker", "status": "%s"}`, id, status)
+	})
+}
+
+func TestCheckNodeGroupImage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	imageGetFixture(t, "active", "active")
+	imageGetFixture(t, "queued", "queued")
+
+	serviceClient := fake.ServiceClient()
+	assert.NoError(t, checkNodeGroupImage(serviceClient, "active"))
+	assert.Error(t, checkNodeGroupImage(serviceClient, "queued"))
+	assert.Error(t, checkNodeGroupImage(serviceClient, "notfound"))
+}
+
+func clusterTemplateVersionFixture(t *testing.T, id, version string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/clustertemplates/%s", id), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"uuid": "%s", "version": "%s"}`, id, version)
+	})
+}
+
+func TestCheckNodeGroupVersion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/clusters/cluster", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"uuid": "cluster", "cluster_template_id": "v1.20"}`)
+	})
+	clusterTemplateVersionFixture(t, "v1.19", "1.19.10")
+	clusterTemplateVersionFixture(t, "v1.20", "1.20.4")
+	clusterTemplateVersionFixture(t, "v1.21", "1.21.4")
+
+	serviceClient := fake.ServiceClient()
+	assert.NoError(t, checkNodeGroupVersion(serviceClient, "cluster", "v1.19"))
+	assert.NoError(t, checkNodeGroupVersion(serviceClient, "cluster", "v1.20"))
+	assert.Error(t, checkNodeGroupVersion(serviceClient, "cluster", "v1.21"))
+}
+
+func TestSelectClusterTemplate(t *testing.T) {
+	newTemplate := func(uuid, version, distro, driver string) clusterTemplate {
+		var template clusterTemplate
+		template.UUID = uuid
+		template.Version = version
+		template.ClusterDistro = distro
+		template.NetworkDriver = driver
+		return template
+	}
+	templates := []clusterTemplate{
+		newTemplate("1.19.10", "1.19.10", "centos", "calico"),
+		newTemplate("1.21.4", "1.21.4", "centos", "calico"),
+		newTemplate("1.20.4", "1.20.4", "centos", "calico"),
+		newTemplate("1.21.1-flannel", "1.21.1", "centos", "flannel"),
+		newTemplate("1.22.1-fedora", "1.22.1", "fedora", "calico"),
+		newTemplate("invalid", "unknown", "centos", "calico"),
+	}
+
+	tests := map[string]struct {
+		filter clusterTemplateFilter
+		uuid   string
+		err    bool
+	}{
+		"latest":             {filter: clusterTemplateFilter{Version: "latest"}, uuid: "1.22.1-fedora"},
+		"empty version":      {filter: clusterTemplateFilter{}, uuid: "1.22.1-fedora"},
+		"exact version":      {filter: clusterTemplateFilter{Version: "1.20.4"}, uuid: "1.20.4"},
+		"pessimistic":        {filter: clusterTemplateFilter{Version: "~> 1.20.0"}, uuid: "1.20.4"},
+		"minor pessimistic":  {filter: clusterTemplateFilter{Version: "~> 1.19"}, uuid: "1.22.1-fedora"},
+		"range":              {filter: clusterTemplateFilter{Version: ">= 1.20, < 1.22"}, uuid: "1.21.4"},
+		"distro":             {filter: clusterTemplateFilter{Version: "latest", ClusterDistro: "centos"}, uuid: "1.21.4"},
+		"network driver":     {filter: clusterTemplateFilter{NetworkDriver: "flannel"}, uuid: "1.21.1-flannel"},
+		"no match":           {filter: clusterTemplateFilter{Version: "~> 1.18.0"}, err: true},
+		"invalid constraint": {filter: clusterTemplateFilter{Version: "newest"}, err: true},
+	}
+
+	for name := range tests {
+		tt := tests[name]
+		t.Run(name, func(t *testing.T) {
+			template, err := selectClusterTemplate(templates, tt.filter)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.uuid, template.UUID)
+		})
+	}
+}
+
+func TestExpandKubernetesClusterFeatureLabels(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceKubernetesCluster().Schema, map[string]interface{}{
+		"ingress":          []interface{}{map[string]interface{}{"controller": "nginx"}},
+		"monitoring":       []interface{}{map[string]interface{}{"enabled": true}},
+		"network_policy":   []interface{}{map[string]interface{}{"calico_ipip_mode": "CrossSubnet"}},
+		"registry_mirrors": []interface{}{"https://mirror1.example.com", "https://mirror2.example.com"},
+	})
+
+	expectedLabels := map[string]string{
+		"ingress_controller":    "nginx",
+		"prometheus_monitoring": "true",
+		"calico_ipv4pool_ipip":  "CrossSubnet",
+		"registry_mirrors":      "https://mirror1.example.com,https://mirror2.example.com",
+	}
+	assert.Equal(t, expectedLabels, expandKubernetesClusterFeatureLabels(d))
+
+	empty := schema.TestResourceDataRaw(t, resourceKubernetesCluster().Schema, map[string]interface{}{})
+	assert.Empty(t, expandKubernetesClusterFeatureLabels(empty))
+}
+
+func TestFlattenKubernetesClusterFeatures(t *testing.T) {
+	labels := map[string]string{
+		"ingress_controller":    "octavia",
+		"prometheus_monitoring": "false",
+		"calico_ipv4pool_ipip":  "Always",
+		"registry_mirrors":      "https://mirror.example.com",
+		"other_label":           "value",
+	}
+
+	expectedFeatures := map[string]interface{}{
+		"ingress":          []map[string]interface{}{{"controller": "octavia"}},
+		"monitoring":       []map[string]interface{}{{"enabled": false}},
+		"network_policy":   []map[string]interface{}{{"calico_ipip_mode": "Always"}},
+		"registry_mirrors": []string{"https://mirror.example.com"},
+	}
+	assert.Equal(t, expectedFeatures, flattenKubernetesClusterFeatures(labels))
+
+	emptyFeatures := map[string]interface{}{
+		"ingress":          []map[string]interface{}{},
+		"monitoring":       []map[string]interface{}{},
+		"network_policy":   []map[string]interface{}{},
+		"registry_mirrors": []string{},
+	}
+	assert.Equal(t, emptyFeatures, flattenKubernetesClusterFeatures(map[string]string{"other_label": "value"}))
+}
+
+func TestExtractKubernetesClusterOIDC(t *testing.T) {
+	oidc, err := extractKubernetesClusterOIDC(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, oidc)
+
+	raw := []interface{}{
+		map[string]interface{}{
+			"issuer_url":     "https://accounts.example.com",
+			"client_id":      "kubernetes",
+			"username_claim": "email",
+			"groups_claim":   "",
+			"ca":             "",
+		},
+	}
+	expected := &clusterOIDC{
+		IssuerURL:     "https://accounts.example.com",
+		ClientID:      "kubernetes",
+		UsernameClaim: "email",
+	}
+
+	oidc, err = extractKubernetesClusterOIDC(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, oidc)
+	assert.Equal(t, raw[0], flattenKubernetesClusterOIDC(oidc)[0])
+
+	b, err := (&clusterCreateOpts{ClusterTemplateID: "1", NetworkID: "1", SubnetID: "1", OIDC: oidc}).Map()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"issuer_url":     "https://accounts.example.com",
+		"client_id":      "kubernetes",
+		"username_claim": "email",
+	}, b["oidc"])
+}
+
+func TestExtractKubernetesClusterAPIAccess(t *testing.T) {
+	assert.Nil(t, extractKubernetesClusterAPIAccess(nil))
+
+	raw := []interface{}{
+		map[string]interface{}{
+			"allowed_cidrs": []interface{}{"10.0.0.0/8", "192.168.1.0/24"},
+		},
+	}
+	expected := &clusterAPIAccess{AllowedCIDRs: []string{"10.0.0.0/8", "192.168.1.0/24"}}
+
+	apiAccess := extractKubernetesClusterAPIAccess(raw)
+	assert.Equal(t, expected, apiAccess)
+	assert.Equal(t, []string{"10.0.0.0/8", "192.168.1.0/24"}, flattenKubernetesClusterAPIAccess(apiAccess)[0]["allowed_cidrs"])
+	assert.Nil(t, flattenKubernetesClusterAPIAccess(&clusterAPIAccess{}))
+}
+
+func TestCheckKubernetesClusterPrivateAccess(t *testing.T) {
+	tests := map[string]struct {
+		floatingIPEnabled bool
+		apiLBVIP          string
+		apiLBFIP          string
+		lbSubnetID        string
+		err               bool
+	}{
+		"floating ip":            {floatingIPEnabled: true, apiLBFIP: "1.1.1.1"},
+		"private":                {floatingIPEnabled: false},
+		"private with vip":       {floatingIPEnabled: false, apiLBVIP: "10.0.0.5", lbSubnetID: "subnet"},
+		"private with fip":       {floatingIPEnabled: false, apiLBFIP: "1.1.1.1", err: true},
+		"private without subnet": {floatingIPEnabled: false, apiLBVIP: "10.0.0.5", err: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := checkKubernetesClusterPrivateAccess(tt.floatingIPEnabled, tt.apiLBVIP, tt.apiLBFIP, tt.lbSubnetID)
+			assert.Equal(t, tt.err, err != nil)
+		})
+	}
+}
+
+func TestCustomizeDiffKubernetesClusterPrivateAccess(t *testing.T) {
+	res := &schema.Resource{
+		Schema:        resourceKubernetesCluster().Schema,
+		CustomizeDiff: customizeDiffKubernetesClusterPrivateAccess,
+	}
+
+	tests := map[string]struct {
+		raw map[string]interface{}
+		err string
+	}{
+		"private with fip": {
+			raw: map[string]interface{}{"floating_ip_enabled": false, "api_lb_fip": "1.1.1.1"},
+			err: "api_lb_fip can't be set when floating_ip_enabled is false",
+		},
+		"private with vip and subnet": {
+			raw: map[string]interface{}{"floating_ip_enabled": false, "api_lb_vip": "10.0.0.5", "loadbalancer_subnet_id": "subnet"},
+		},
+		"floating ip with fip": {
+			raw: map[string]interface{}{"floating_ip_enabled": true, "api_lb_fip": "1.1.1.1"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := res.Diff(nil, terraform.NewResourceConfigRaw(tt.raw), nil)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCustomizeDiffNodeGroupVersion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/clusters/cluster", `{"uuid": "cluster", "cluster_template_id": "v1.20"}`)
+	handleGetFixture(t, "/clustertemplates/", `{"clustertemplates": [
+		{"uuid": "v1.19", "version": "1.19.10"},
+		{"uuid": "v1.20", "version": "1.20.4"},
+		{"uuid": "v1.21", "version": "1.21.4"}
+	]}`)
+	clusterTemplateVersionFixture(t, "v1.19", "1.19.10")
+	clusterTemplateVersionFixture(t, "v1.20", "1.20.4")
+	clusterTemplateVersionFixture(t, "v1.21", "1.21.4")
+
+	config := &dummyConfig{}
+	config.On("GetRegion").Return("RegionOne")
+	config.On("ContainerInfraV1Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	res := &schema.Resource{
+		Schema:        resourceKubernetesNodeGroup().Schema,
+		CustomizeDiff: customizeDiffNodeGroupVersion,
+	}
+	state := &terraform.InstanceState{
+		ID: "node-group",
+		Attributes: map[string]string{
+			"cluster_id":           "cluster",
+			"name":                 "ng",
+			"cluster_template_id":  "v1.19",
+			"kubernetes_version":   "1.19.10",
+			"availability_zones.#": "1",
+			"availability_zones.0": "MS1",
+			"security_group_ids.#": "0",
+		},
+	}
+
+	tests := map[string]struct {
+		raw      map[string]interface{}
+		computed string
+		err      string
+	}{
+		"template": {
+			raw:      map[string]interface{}{"cluster_template_id": "v1.20"},
+			computed: "kubernetes_version",
+		},
+		"template ahead": {
+			raw: map[string]interface{}{"cluster_template_id": "v1.21"},
+			err: "node group version 1.21.4 can't be greater than cluster masters version 1.20.4, upgrade the cluster first",
+		},
+		"version": {
+			raw:      map[string]interface{}{"kubernetes_version": "1.20.4"},
+			computed: "cluster_template_id",
+		},
+		"version ahead": {
+			raw: map[string]interface{}{"kubernetes_version": "1.21.4"},
+			err: "node group version 1.21.4 can't be greater than cluster masters version 1.20.4, upgrade the cluster first",
+		},
+		"unknown version": {
+			raw: map[string]interface{}{"kubernetes_version": "1.18.1"},
+			err: `no cluster template matches version "1.18.1", cluster_distro "", network_driver ""`,
+		},
+		"unchanged": {
+			raw: map[string]interface{}{"kubernetes_version": "1.19.10"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{"cluster_id": "cluster", "name": "ng"}
+			for k, v := range tt.raw {
+				raw[k] = v
+			}
+			diff, err := res.Diff(state, terraform.NewResourceConfigRaw(raw), config)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			for _, k := range []string{"cluster_template_id", "kubernetes_version"} {
+				assert.Equal(t, k == tt.computed, diff.Attributes[k] != nil && diff.Attributes[k].NewComputed, k)
+			}
+		})
+	}
+}
+
+func TestCustomizeDiffNodeGroupHealthy(t *testing.T) {
+	res := &schema.Resource{
+		Schema:        resourceKubernetesNodeGroup().Schema,
+		CustomizeDiff: customizeDiffNodeGroupHealthy,
+	}
+
+	tests := map[string]struct {
+		autoRepair  bool
+		healthy     interface{}
+		requiresNew bool
+		changed     bool
+	}{
+		"recreated": {
+			requiresNew: true,
+			changed:     true,
+		},
+		"repaired": {
+			autoRepair: true,
+			changed:    true,
+		},
+		"ignored": {
+			healthy: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "node-group",
+				Attributes: map[string]string{
+					"cluster_id":           "cluster",
+					"name":                 "ng",
+					"node_count":           "3",
+					"healthy":              "false",
+					"auto_repair":          strconv.FormatBool(tt.autoRepair),
+					"availability_zones.#": "0",
+					"security_group_ids.#": "0",
+					"volume_size":          "10",
+					"volume_type":          "ceph-ssd",
+					"flavor_id":            "flavor",
+				},
+			}
+			raw := map[string]interface{}{"cluster_id": "cluster", "name": "ng", "node_count": 3, "auto_repair": tt.autoRepair}
+			if tt.healthy != nil {
+				raw["healthy"] = tt.healthy
+			}
+
+			diff, err := res.Diff(state, terraform.NewResourceConfigRaw(raw), nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.changed, diff != nil && diff.Attributes["healthy"] != nil)
+			assert.Equal(t, tt.requiresNew, diff.RequiresNew())
+		})
+	}
+}
+
+func TestKubernetesNodeGroupHealthRefreshFunc(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/nodegroups/healthy", `{"uuid": "healthy", "node_count": 2, "nodes": [{"status": "Ready"}, {"status": "Ready"}]}`)
+	handleGetFixture(t, "/nodegroups/unhealthy", `{"uuid": "unhealthy", "node_count": 2, "nodes": [{"status": "Ready"}, {"status": "NotReady"}]}`)
+
+	_, state, err := kubernetesNodeGroupHealthRefreshFunc(fake.ServiceClient(), "healthy")()
+	assert.NoError(t, err)
+	assert.Equal(t, nodeGroupHealthy, state)
+
+	_, state, err = kubernetesNodeGroupHealthRefreshFunc(fake.ServiceClient(), "unhealthy")()
+	assert.NoError(t, err)
+	assert.Equal(t, nodeGroupUnhealthy, state)
+}
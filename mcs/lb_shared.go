@@ -0,0 +1,96 @@
+package mcs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	lbCreateTimeout = 10
+	lbUpdateTimeout = 10
+	lbDeleteTimeout = 10
+	lbDelay         = 5
+	lbMinTimeout    = 3
+)
+
+type lbProvisioningStatus string
+
+const (
+	lbProvisioningStatusActive        lbProvisioningStatus = "ACTIVE"
+	lbProvisioningStatusPendingCreate lbProvisioningStatus = "PENDING_CREATE"
+	lbProvisioningStatusPendingUpdate lbProvisioningStatus = "PENDING_UPDATE"
+	lbProvisioningStatusPendingDelete lbProvisioningStatus = "PENDING_DELETE"
+	lbProvisioningStatusDeleted       lbProvisioningStatus = "DELETED"
+	lbProvisioningStatusError         lbProvisioningStatus = "ERROR"
+)
+
+func getLBPendingStatuses() []string {
+	return []string{
+		string(lbProvisioningStatusPendingCreate),
+		string(lbProvisioningStatusPendingUpdate),
+		string(lbProvisioningStatusPendingDelete),
+	}
+}
+
+func lbLoadBalancerStateRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		lb, err := loadbalancers.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return lb, string(lbProvisioningStatusDeleted), nil
+			}
+			return nil, "", err
+		}
+
+		if lb.ProvisioningStatus == string(lbProvisioningStatusError) {
+			return lb, lb.ProvisioningStatus, fmt.Errorf("there was an error provisioning the load balancer")
+		}
+
+		return lb, lb.ProvisioningStatus, nil
+	}
+}
+
+// lbWaitForLoadBalancerActive waits for the load balancer to leave pending statuses.
+// Load balancer rejects changes of its listeners, pools, members and monitors while it is pending.
+// The load balancer becomes pending before the change is accepted, so it is polled without a delay.
+func lbWaitForLoadBalancerActive(client *gophercloud.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    getLBPendingStatuses(),
+		Target:     []string{string(lbProvisioningStatusActive)},
+		Refresh:    lbLoadBalancerStateRefreshFunc(client, id),
+		Timeout:    timeout,
+		MinTimeout: lbMinTimeout * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for load balancer %s to become active: %s", id, err)
+	}
+	return nil
+}
+
+// lbLoadBalancerIDByPool returns ID of the load balancer the pool belongs to.
+func lbLoadBalancerIDByPool(client *gophercloud.ServiceClient, poolID string) (string, error) {
+	pool, err := pools.Get(client, poolID).Extract()
+	if err != nil {
+		return "", err
+	}
+	if len(pool.Loadbalancers) > 0 {
+		return pool.Loadbalancers[0].ID, nil
+	}
+	if len(pool.Listeners) > 0 {
+		listener, err := listeners.Get(client, pool.Listeners[0].ID).Extract()
+		if err != nil {
+			return "", err
+		}
+		if len(listener.Loadbalancers) > 0 {
+			return listener.Loadbalancers[0].ID, nil
+		}
+	}
+	return "", fmt.Errorf("unable to find load balancer of pool %s", poolID)
+}
@@ -0,0 +1,47 @@
+package mcs
+
+import (
+	"fmt"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLBLoadBalancerStateRefreshFunc(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/lbaas/loadbalancers/active", fmt.Sprintf(lbLoadBalancerGetFixture, "ACTIVE"))
+	handleGetFixture(t, "/lbaas/loadbalancers/error", fmt.Sprintf(lbLoadBalancerGetFixture, "ERROR"))
+
+	cases := map[string]struct {
+		expectedState string
+		expectedErr   bool
+	}{
+		"active":   {expectedState: "ACTIVE"},
+		"error":    {expectedState: "ERROR", expectedErr: true},
+		"notfound": {expectedState: "DELETED"},
+	}
+
+	for id, tc := range cases {
+		t.Run(id, func(t *testing.T) {
+			_, state, err := lbLoadBalancerStateRefreshFunc(fake.ServiceClient(), id)()
+			assert.Equal(t, tc.expectedErr, err != nil)
+			assert.Equal(t, tc.expectedState, state)
+		})
+	}
+}
+
+func TestLBLoadBalancerIDByPool(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/lbaas/pools/pool", lbPoolGetFixture)
+	handleGetFixture(t, "/lbaas/listeners/listener", fmt.Sprintf(lbListenerGetFixture, "db"))
+
+	lbID, err := lbLoadBalancerIDByPool(fake.ServiceClient(), "pool")
+	assert.NoError(t, err)
+	assert.Equal(t, "lb", lbID)
+}
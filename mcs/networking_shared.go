@@ -0,0 +1,227 @@
+package mcs
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/external"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/portsecurity"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	networkingCreateTimeout = 10
+	networkingDeleteTimeout = 10
+	networkingDelay         = 5
+	networkingMinTimeout    = 3
+)
+
+var errNetworkingFloatingIPNotAllocated = errors.New("floating IP is not allocated")
+
+// networkExtended is a network with attributes of external and port security extensions.
+type networkExtended struct {
+	networks.Network
+	external.NetworkExternalExt
+	portsecurity.PortSecurityExt
+}
+
+func networkingNetworkGet(client *gophercloud.ServiceClient, id string) (*networkExtended, error) {
+	var n networkExtended
+	if err := networks.Get(client, id).ExtractInto(&n); err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// networkingNetworkIDByName returns ID of the only network having the name.
+func networkingNetworkIDByName(client *gophercloud.ServiceClient, name string) (string, error) {
+	allPages, err := networks.List(client, networks.ListOpts{Name: name}).AllPages()
+	if err != nil {
+		return "", err
+	}
+	allNetworks, err := networks.ExtractNetworks(allPages)
+	if err != nil {
+		return "", err
+	}
+	if len(allNetworks) != 1 {
+		return "", fmt.Errorf("found %d networks named %q, exactly one is expected", len(allNetworks), name)
+	}
+	return allNetworks[0].ID, nil
+}
+
+// networkingFloatingIPGetByAddress returns floating IP allocated to the project by its address.
+func networkingFloatingIPGetByAddress(client *gophercloud.ServiceClient, address string) (*floatingips.FloatingIP, error) {
+	allPages, err := floatingips.List(client, floatingips.ListOpts{FloatingIP: address}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allFloatingIPs, err := floatingips.ExtractFloatingIPs(allPages)
+	if err != nil {
+		return nil, err
+	}
+	if len(allFloatingIPs) != 1 {
+		return nil, errNetworkingFloatingIPNotAllocated
+	}
+	return &allFloatingIPs[0], nil
+}
+
+// checkNetworkingFloatingIPAvailable ensures that floating IP is allocated and not associated with any port.
+func checkNetworkingFloatingIPAvailable(client *gophercloud.ServiceClient, address string) error {
+	fip, err := networkingFloatingIPGetByAddress(client, address)
+	if err != nil {
+		return fmt.Errorf("error retrieving floating IP %s: %s", address, err)
+	}
+	if fip.PortID != "" {
+		return fmt.Errorf("floating IP %s is already associated with port %s", address, fip.PortID)
+	}
+	return nil
+}
+
+// customizeDiffFloatingIPAvailable validates on plan that a newly set floating IP is allocated and free.
+func customizeDiffFloatingIPAvailable(key string) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		if !d.HasChange(key) || !d.NewValueKnown(key) {
+			return nil
+		}
+		address := d.Get(key).(string)
+		if address == "" {
+			return nil
+		}
+
+		config := meta.(configer)
+		networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("error creating networking client: %s", err)
+		}
+		return checkNetworkingFloatingIPAvailable(networkingClient, address)
+	}
+}
+
+// networkingFloatingIPAssociate associates floating IP with the port, empty port disassociates it.
+func networkingFloatingIPAssociate(client *gophercloud.ServiceClient, id, portID, fixedIP string) error {
+	updateOpts := floatingips.UpdateOpts{
+		PortID:  &portID,
+		FixedIP: fixedIP,
+	}
+	return floatingips.Update(client, id, updateOpts).Err
+}
+
+func networkingNetworkStateRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		n, err := networks.Get(client, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+		return n, n.Status, nil
+	}
+}
+
+func networkingRouterStateRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		r, err := routers.Get(client, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+		return r, r.Status, nil
+	}
+}
+
+func networkingPortStateRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		p, err := ports.Get(client, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+		return p, p.Status, nil
+	}
+}
+
+// networkingDeleteRefreshFunc repeats deletion of networking resource while it is in use
+// and reports DELETED once the resource is gone.
+func networkingDeleteRefreshFunc(get func() error, del func() error) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		if err := get(); err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return struct{}{}, "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		if err := del(); err != nil {
+			switch err.(type) {
+			case gophercloud.ErrDefault404:
+				return struct{}{}, "DELETED", nil
+			case gophercloud.ErrDefault409:
+				return struct{}{}, "ACTIVE", nil
+			}
+			return nil, "", err
+		}
+
+		return struct{}{}, "ACTIVE", nil
+	}
+}
+
+// networkingSubnetDefaultGateway returns the first address of the CIDR which subnets get as a gateway by default.
+func networkingSubnetDefaultGateway(cidr string) (string, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", err
+	}
+	ip := ipNet.IP.To4()
+	if ip == nil {
+		return "", fmt.Errorf("only IPv4 subnets are supported: %s", cidr)
+	}
+	gateway := make(net.IP, len(ip))
+	copy(gateway, ip)
+	gateway[len(gateway)-1]++
+	return gateway.String(), nil
+}
+
+func expandNetworkingSubnetAllocationPools(v []interface{}) []subnets.AllocationPool {
+	pools := make([]subnets.AllocationPool, 0, len(v))
+	for _, raw := range v {
+		pool := raw.(map[string]interface{})
+		pools = append(pools, subnets.AllocationPool{
+			Start: pool["start"].(string),
+			End:   pool["end"].(string),
+		})
+	}
+	return pools
+}
+
+func flattenNetworkingSubnetAllocationPools(pools []subnets.AllocationPool) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(pools))
+	for _, pool := range pools {
+		result = append(result, map[string]interface{}{
+			"start": pool.Start,
+			"end":   pool.End,
+		})
+	}
+	return result
+}
+
+func flattenNetworkingRouterExternalFixedIPs(ips []routers.ExternalFixedIP) []string {
+	result := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		result = append(result, ip.IPAddress)
+	}
+	return result
+}
+
+func expandToStringSlice(v []interface{}) []string {
+	s := make([]string, 0, len(v))
+	for _, val := range v {
+		if str, ok := val.(string); ok {
+			s = append(s, str)
+		}
+	}
+	return s
+}
@@ -0,0 +1,56 @@
+package mcs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkingDeleteRefreshFunc(t *testing.T) {
+	errNotFound := gophercloud.ErrDefault404{}
+	errInUse := gophercloud.ErrDefault409{}
+	errFailed := fmt.Errorf("failed")
+	ok := func() error { return nil }
+
+	cases := map[string]struct {
+		get, del      func() error
+		expectedState string
+		expectedErr   error
+	}{
+		"gone before delete": {
+			get:           func() error { return errNotFound },
+			del:           ok,
+			expectedState: "DELETED",
+		},
+		"gone on delete": {
+			get:           ok,
+			del:           func() error { return errNotFound },
+			expectedState: "DELETED",
+		},
+		"in use": {
+			get:           ok,
+			del:           func() error { return errInUse },
+			expectedState: "ACTIVE",
+		},
+		"deleted": {
+			get:           ok,
+			del:           ok,
+			expectedState: "ACTIVE",
+		},
+		"delete failed": {
+			get:         ok,
+			del:         func() error { return errFailed },
+			expectedErr: errFailed,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, state, err := networkingDeleteRefreshFunc(tc.get, tc.del)()
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedState, state)
+		})
+	}
+}
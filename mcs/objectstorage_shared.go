@@ -0,0 +1,187 @@
+package mcs
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/ec2credentials"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	defaultObjectStorageEndpoint = "https://hb.bizmrg.com"
+	defaultObjectStorageRegion   = "ru-msk"
+)
+
+// objectStorageConfig holds settings of the S3-compatible object storage client.
+type objectStorageConfig struct {
+	Endpoint       string
+	Region         string
+	AccessKey      string
+	SecretKey      string
+	ForcePathStyle bool
+	// CreateEC2Credentials allows to create EC2 credentials of the user if neither access keys
+	// nor existing EC2 credentials are found.
+	CreateEC2Credentials bool
+}
+
+func objectStorageSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Settings of the S3-compatible object storage.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"endpoint": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"region": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"access_key": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"secret_key": {
+					Type:      schema.TypeString,
+					Optional:  true,
+					Sensitive: true,
+				},
+				"force_path_style": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"create_ec2_credentials": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// expandObjectStorageConfig returns settings of the objectstorage provider block, falling back to
+// environment variables and defaults.
+func expandObjectStorageConfig(v []interface{}) objectStorageConfig {
+	cfg := objectStorageConfig{
+		Endpoint:  os.Getenv("OBJECTSTORAGE_ENDPOINT"),
+		Region:    os.Getenv("OBJECTSTORAGE_REGION"),
+		AccessKey: os.Getenv("OBJECTSTORAGE_ACCESS_KEY"),
+		SecretKey: os.Getenv("OBJECTSTORAGE_SECRET_KEY"),
+	}
+
+	if len(v) > 0 && v[0] != nil {
+		block := v[0].(map[string]interface{})
+		if endpoint := block["endpoint"].(string); endpoint != "" {
+			cfg.Endpoint = endpoint
+		}
+		if region := block["region"].(string); region != "" {
+			cfg.Region = region
+		}
+		if accessKey := block["access_key"].(string); accessKey != "" {
+			cfg.AccessKey = accessKey
+		}
+		if secretKey := block["secret_key"].(string); secretKey != "" {
+			cfg.SecretKey = secretKey
+		}
+		cfg.ForcePathStyle = block["force_path_style"].(bool)
+		cfg.CreateEC2Credentials = block["create_ec2_credentials"].(bool)
+	}
+
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = defaultObjectStorageEndpoint
+	}
+	if cfg.Region == "" {
+		cfg.Region = defaultObjectStorageRegion
+	}
+	return cfg
+}
+
+func newObjectStorageClient(cfg objectStorageConfig, httpClient *http.Client) (s3iface.S3API, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:         aws.String(cfg.Endpoint),
+		Region:           aws.String(cfg.Region),
+		Credentials:      credentials.NewStaticCredentials(cfg.AccessKey, cfg.SecretKey, ""),
+		S3ForcePathStyle: aws.Bool(cfg.ForcePathStyle),
+		HTTPClient:       httpClient,
+		MaxRetries:       aws.Int(maxRetriesCount),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s3.New(sess), nil
+}
+
+// objectStorageEC2Credentials returns EC2 credentials of the authenticated user in the project.
+// If the user has none, they are created only when create is set.
+func objectStorageEC2Credentials(identityClient *gophercloud.ServiceClient, projectID string, create bool) (string, string, error) {
+	authResult, ok := identityClient.ProviderClient.GetAuthResult().(tokens.CreateResult)
+	if !ok {
+		return "", "", fmt.Errorf("unable to determine the authenticated user")
+	}
+	user, err := authResult.ExtractUser()
+	if err != nil {
+		return "", "", fmt.Errorf("unable to determine the authenticated user: %s", err)
+	}
+
+	allPages, err := ec2credentials.List(identityClient, user.ID).AllPages()
+	if err != nil {
+		return "", "", fmt.Errorf("error retrieving EC2 credentials: %s", err)
+	}
+	allCredentials, err := ec2credentials.ExtractCredentials(allPages)
+	if err != nil {
+		return "", "", fmt.Errorf("error extracting EC2 credentials: %s", err)
+	}
+	for _, c := range allCredentials {
+		if c.TenantID == projectID {
+			return c.Access, c.Secret, nil
+		}
+	}
+
+	if !create {
+		return "", "", fmt.Errorf("the user has no EC2 credentials in project %s: set access_key and secret_key "+
+			"of the objectstorage provider block or set create_ec2_credentials to create them", projectID)
+	}
+
+	c, err := ec2credentials.Create(identityClient, user.ID, ec2credentials.CreateOpts{TenantID: projectID}).Extract()
+	if err != nil {
+		return "", "", fmt.Errorf("error creating EC2 credentials: %s", err)
+	}
+	return c.Access, c.Secret, nil
+}
+
+// isObjectStorageNotFound checks whether the error of the object storage is caused by a missing bucket or object.
+func isObjectStorageNotFound(err error) bool {
+	if reqErr, ok := err.(awserr.RequestFailure); ok && reqErr.StatusCode() == http.StatusNotFound {
+		return true
+	}
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch awsErr.Code() {
+		case s3.ErrCodeNoSuchBucket, s3.ErrCodeNoSuchKey, "NotFound":
+			return true
+		}
+	}
+	return false
+}
+
+// parseObjectStorageObjectID splits <bucket>/<key> ID of the object.
+func parseObjectStorageObjectID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid format specified for mcs_objectstorage_object, format must be <bucket>/<key>")
+	}
+	return parts[0], parts[1], nil
+}
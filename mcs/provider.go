@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/utils/terraform/auth"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/meta"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
@@ -28,12 +31,27 @@ type configer interface {
 	IdentityV3Client(region string) (ContainerClient, error)
 	ContainerInfraV1Client(region string) (ContainerClient, error)
 	DatabaseV1Client(region string) (ContainerClient, error)
+	ImageV2Client(region string) (*gophercloud.ServiceClient, error)
+	NetworkingV2Client(region string) (*gophercloud.ServiceClient, error)
+	LoadBalancerV2Client(region string) (*gophercloud.ServiceClient, error)
+	ComputeV2Client(region string) (*gophercloud.ServiceClient, error)
+	BlockStorageV3Client(region string) (*gophercloud.ServiceClient, error)
+	DNSV2Client(region string) (*gophercloud.ServiceClient, error)
+	ObjectStorageClient() (s3iface.S3API, error)
 	GetRegion() string
+	GetDefaultTags() map[string]string
+	GetProjectID() string
+	GetQuotaPreflight() string
 }
 
 // config uses openstackbase.Config as the base/foundation of this provider's
 type config struct {
 	auth.Config
+	defaultTags    map[string]string
+	quotaPreflight string
+
+	objectStorage      objectStorageConfig
+	objectStorageMutex sync.Mutex
 }
 
 var _ configer = &config{}
@@ -43,6 +61,21 @@ func (c *config) GetRegion() string {
 	return c.Region
 }
 
+// GetDefaultTags returns tags to be set on every resource
+func (c *config) GetDefaultTags() map[string]string {
+	return c.defaultTags
+}
+
+// GetProjectID returns ID of the project the provider works in
+func (c *config) GetProjectID() string {
+	return c.TenantID
+}
+
+// GetQuotaPreflight returns mode of quotas check on plan, it is empty if the check is disabled
+func (c *config) GetQuotaPreflight() string {
+	return c.quotaPreflight
+}
+
 // IdentityV3Client is implementation of ContainerInfraV1Client method
 func (c *config) IdentityV3Client(region string) (ContainerClient, error) {
 	return c.Config.IdentityV3Client(region)
@@ -77,13 +110,64 @@ func (c *config) DatabaseV1Client(region string) (ContainerClient, error) {
 	return client, clientErr
 }
 
+// ImageV2Client is implementation of ImageV2Client method
+func (c *config) ImageV2Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.Config.ImageV2Client(region)
+}
+
+// NetworkingV2Client is implementation of NetworkingV2Client method
+func (c *config) NetworkingV2Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.Config.NetworkingV2Client(region)
+}
+
+// LoadBalancerV2Client is implementation of LoadBalancerV2Client method
+func (c *config) LoadBalancerV2Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.Config.LoadBalancerV2Client(region)
+}
+
+// ComputeV2Client is implementation of ComputeV2Client method
+func (c *config) ComputeV2Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.Config.ComputeV2Client(region)
+}
+
+// BlockStorageV3Client is implementation of BlockStorageV3Client method
+func (c *config) BlockStorageV3Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.Config.BlockStorageV3Client(region)
+}
+
+// DNSV2Client is implementation of DNSV2Client method
+func (c *config) DNSV2Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.Config.DNSV2Client(region)
+}
+
+// ObjectStorageClient returns S3-compatible object storage client. Without access keys configured
+// EC2 credentials of the provider user are used, they are created only if create_ec2_credentials is set.
+func (c *config) ObjectStorageClient() (s3iface.S3API, error) {
+	c.objectStorageMutex.Lock()
+	defer c.objectStorageMutex.Unlock()
+
+	if c.objectStorage.AccessKey == "" || c.objectStorage.SecretKey == "" {
+		identityClient, err := c.Config.IdentityV3Client(c.Region)
+		if err != nil {
+			return nil, fmt.Errorf("error creating identity client: %s", err)
+		}
+		accessKey, secretKey, err := objectStorageEC2Credentials(identityClient, c.TenantID, c.objectStorage.CreateEC2Credentials)
+		if err != nil {
+			return nil, err
+		}
+		c.objectStorage.AccessKey, c.objectStorage.SecretKey = accessKey, secretKey
+	}
+
+	return newObjectStorageClient(c.objectStorage, &http.Client{Transport: c.OsClient.HTTPClient.Transport})
+}
+
 func newConfig(d *schema.ResourceData, terraformVersion string) (configer, error) {
 	if os.Getenv("TF_ACC_MOCK_MCS") != "" {
 		return &dummyConfig{}, nil
 	}
 
 	config := &config{
-		auth.Config{
+		Config: auth.Config{
 			CACertFile:       d.Get("cacert_file").(string),
 			ClientCertFile:   d.Get("cert").(string),
 			ClientKeyFile:    d.Get("key").(string),
@@ -95,6 +179,9 @@ func newConfig(d *schema.ResourceData, terraformVersion string) (configer, error
 			TerraformVersion: terraformVersion,
 			SDKVersion:       meta.SDKVersionString(),
 		},
+		defaultTags:    expandDefaultTags(d.Get("default_tags").([]interface{})),
+		quotaPreflight: d.Get("quota_preflight").(string),
+		objectStorage:  expandObjectStorageConfig(d.Get("objectstorage").([]interface{})),
 	}
 
 	if config.TenantID == "" {
@@ -206,28 +293,87 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("KEY", ""),
 				Description: "A client private key to authenticate with.",
 			},
+			"objectstorage": objectStorageSchema(),
+			"quota_preflight": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("QUOTA_PREFLIGHT", ""),
+				ValidateFunc: validation.StringInSlice([]string{quotaPreflightWarn, quotaPreflightError}, false),
+				Description:  "Check on plan that requested resources fit into project quotas: `warn` only logs exceeded quotas, `error` fails the plan.",
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags to be set on kubernetes clusters and node groups and on database instances and clusters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"mcs_kubernetes_clustertemplate":  dataSourceKubernetesClusterTemplate(),
 			"mcs_kubernetes_clustertemplates": dataSourceKubernetesClusterTemplates(),
 			"mcs_kubernetes_cluster":          dataSourceKubernetesCluster(),
+			"mcs_kubernetes_clusters":         dataSourceKubernetesClusters(),
 			"mcs_kubernetes_node_group":       dataSourceKubernetesNodeGroup(),
+			"mcs_kubernetes_addons":           dataSourceKubernetesAddons(),
 			"mcs_db_instance":                 dataSourceDatabaseInstance(),
 			"mcs_db_user":                     dataSourceDatabaseUser(),
 			"mcs_db_database":                 dataSourceDatabaseDatabase(),
+			"mcs_networking_network":          dataSourceNetworkingNetwork(),
+			"mcs_networking_subnet":           dataSourceNetworkingSubnet(),
+			"mcs_networking_router":           dataSourceNetworkingRouter(),
+			"mcs_compute_keypair":             dataSourceComputeKeypair(),
+			"mcs_compute_instance":            dataSourceComputeInstance(),
+			"mcs_compute_flavor":              dataSourceComputeFlavor(),
+			"mcs_compute_flavors":             dataSourceComputeFlavors(),
+			"mcs_images_image":                dataSourceImagesImage(),
+			"mcs_images_images":               dataSourceImagesImages(),
+			"mcs_blockstorage_volume_types":   dataSourceBlockStorageVolumeTypes(),
+			"mcs_project_quotas":              dataSourceProjectQuotas(),
 			"mcs_region":                      dataSourceMcsRegion(),
 			"mcs_regions":                     dataSourceMcsRegions(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"mcs_kubernetes_cluster":     resourceKubernetesCluster(),
-			"mcs_kubernetes_node_group":  resourceKubernetesNodeGroup(),
-			"mcs_db_instance":            resourceDatabaseInstance(),
-			"mcs_db_user":                resourceDatabaseUser(),
-			"mcs_db_database":            resourceDatabaseDatabase(),
-			"mcs_db_cluster":             resourceDatabaseCluster(),
-			"mcs_db_cluster_with_shards": resourceDatabaseClusterWithShards(),
+			"mcs_kubernetes_cluster":              resourceKubernetesCluster(),
+			"mcs_kubernetes_node_group":           resourceKubernetesNodeGroup(),
+			"mcs_kubernetes_addon":                resourceKubernetesAddon(),
+			"mcs_db_instance":                     resourceDatabaseInstance(),
+			"mcs_db_user":                         resourceDatabaseUser(),
+			"mcs_db_database":                     resourceDatabaseDatabase(),
+			"mcs_db_cluster":                      resourceDatabaseCluster(),
+			"mcs_db_cluster_with_shards":          resourceDatabaseClusterWithShards(),
+			"mcs_networking_network":              resourceNetworkingNetwork(),
+			"mcs_networking_subnet":               resourceNetworkingSubnet(),
+			"mcs_networking_router":               resourceNetworkingRouter(),
+			"mcs_networking_router_interface":     resourceNetworkingRouterInterface(),
+			"mcs_networking_secgroup":             resourceNetworkingSecGroup(),
+			"mcs_networking_secgroup_rule":        resourceNetworkingSecGroupRule(),
+			"mcs_networking_floatingip":           resourceNetworkingFloatingIP(),
+			"mcs_networking_floatingip_associate": resourceNetworkingFloatingIPAssociate(),
+			"mcs_lb_loadbalancer":                 resourceLoadBalancer(),
+			"mcs_lb_listener":                     resourceLoadBalancerListener(),
+			"mcs_lb_pool":                         resourceLoadBalancerPool(),
+			"mcs_lb_member":                       resourceLoadBalancerMember(),
+			"mcs_lb_monitor":                      resourceLoadBalancerMonitor(),
+			"mcs_compute_keypair":                 resourceComputeKeypair(),
+			"mcs_compute_instance":                resourceComputeInstance(),
+			"mcs_compute_volume_attach":           resourceComputeVolumeAttach(),
+			"mcs_blockstorage_volume":             resourceBlockStorageVolume(),
+			"mcs_blockstorage_snapshot":           resourceBlockStorageSnapshot(),
+			"mcs_dns_zone":                        resourceDNSZone(),
+			"mcs_dns_recordset":                   resourceDNSRecordSet(),
+			"mcs_objectstorage_bucket":            resourceObjectStorageBucket(),
+			"mcs_objectstorage_object":            resourceObjectStorageObject(),
 		},
 	}
 
@@ -3,9 +3,12 @@ package mcs
 import (
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"testing"
 
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/pathorcontents"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
@@ -218,3 +221,37 @@ func envVarFile(varName string) (string, error) {
 	}
 	return tmpFile.Name(), nil
 }
+
+// handleFixture serves the fixture body on the path of the fake API for requests with the method.
+func handleFixture(t *testing.T, method, path string, status int, body string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, method)
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprint(w, body)
+	})
+}
+
+// handleGetFixture serves the fixture body on the path of the fake API for GET requests.
+func handleGetFixture(t *testing.T, path, body string) {
+	handleFixture(t, http.MethodGet, path, http.StatusOK, body)
+}
+
+// testResourceDataUpdate returns resource data of the resource in the state updated with the raw config.
+func testResourceDataUpdate(t *testing.T, res *schema.Resource, id string, state map[string]string, raw map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	instanceState := &terraform.InstanceState{ID: id, Attributes: state}
+	sm := schema.InternalMap(res.Schema)
+	diff, err := sm.Diff(instanceState, terraform.NewResourceConfigRaw(raw), nil, nil, true)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	d, err := sm.Data(instanceState, diff)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return d
+}
@@ -0,0 +1,418 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/limits"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	quotaPreflightWarn  = "warn"
+	quotaPreflightError = "error"
+)
+
+const (
+	quotaCategoryCompute = "compute"
+	quotaCategoryVolume  = "volume"
+	quotaCategoryNetwork = "network"
+	quotaCategoryDBaaS   = "dbaas"
+)
+
+// projectQuota is a limit of a project resource and its current usage. Negative limit means unlimited.
+type projectQuota struct {
+	Limit int
+	Used  int
+}
+
+// projectQuotas are quotas of a service category keyed by resource names.
+type projectQuotas map[string]projectQuota
+
+func computeProjectQuotas(client *gophercloud.ServiceClient) (projectQuotas, error) {
+	l, err := limits.Get(client, nil).Extract()
+	if err != nil {
+		return nil, err
+	}
+	return projectQuotas{
+		"cores":     {Limit: l.Absolute.MaxTotalCores, Used: l.Absolute.TotalCoresUsed},
+		"ram":       {Limit: l.Absolute.MaxTotalRAMSize, Used: l.Absolute.TotalRAMUsed},
+		"instances": {Limit: l.Absolute.MaxTotalInstances, Used: l.Absolute.TotalInstancesUsed},
+	}, nil
+}
+
+func blockStorageProjectQuotas(client *gophercloud.ServiceClient, projectID string) (projectQuotas, error) {
+	q, err := quotasets.GetUsage(client, projectID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	usage := func(u quotasets.QuotaUsage) projectQuota {
+		return projectQuota{Limit: u.Limit, Used: u.InUse + u.Reserved}
+	}
+	return projectQuotas{
+		"volumes":   usage(q.Volumes),
+		"gigabytes": usage(q.Gigabytes),
+		"snapshots": usage(q.Snapshots),
+	}, nil
+}
+
+func networkingProjectQuotas(client *gophercloud.ServiceClient, projectID string) (projectQuotas, error) {
+	q, err := quotas.GetDetail(client, projectID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	usage := func(u quotas.QuotaDetail) projectQuota {
+		return projectQuota{Limit: u.Limit, Used: u.Used + u.Reserved}
+	}
+	return projectQuotas{
+		"floatingip":     usage(q.FloatingIP),
+		"network":        usage(q.Network),
+		"subnet":         usage(q.Subnet),
+		"router":         usage(q.Router),
+		"port":           usage(q.Port),
+		"security_group": usage(q.SecurityGroup),
+	}, nil
+}
+
+var databaseLimitsAPIPath = "limits"
+
+// databaseProjectQuotas returns absolute limits of the database service. The service does not report usage,
+// it is counted from instances of the project.
+func databaseProjectQuotas(client databaseClient) (projectQuotas, error) {
+	var body struct {
+		Limits []map[string]interface{} `json:"limits"`
+	}
+	if _, err := client.Get(baseURL(client, databaseLimitsAPIPath), &body, getRequestOpts(http.StatusOK)); err != nil {
+		return nil, err
+	}
+
+	limit := func(l map[string]interface{}, key string) int {
+		if v, ok := l[key].(float64); ok {
+			return int(v)
+		}
+		return -1
+	}
+	q := projectQuotas{
+		"instances": {Limit: -1},
+		"volumes":   {Limit: -1},
+	}
+	for _, l := range body.Limits {
+		if l["verb"] == "ABSOLUTE" {
+			q["instances"] = projectQuota{Limit: limit(l, "max_instances")}
+			q["volumes"] = projectQuota{Limit: limit(l, "max_volumes")}
+		}
+	}
+
+	allInstances, err := instanceList(client)
+	if err != nil {
+		return nil, err
+	}
+	var volumes int
+	for _, i := range allInstances {
+		if i.Volume != nil && i.Volume.Size != nil {
+			volumes += *i.Volume.Size
+		}
+	}
+	q["instances"] = projectQuota{Limit: q["instances"].Limit, Used: len(allInstances)}
+	q["volumes"] = projectQuota{Limit: q["volumes"].Limit, Used: volumes}
+
+	return q, nil
+}
+
+// projectQuotaServers is a group of servers of the same flavor requested by a resource.
+type projectQuotaServers struct {
+	FlavorID string
+	Count    int
+	// VolumeSizes are sizes of volumes of every server in GB.
+	VolumeSizes []int
+}
+
+// projectQuotaRequest describes project resources consumed by a resource.
+type projectQuotaRequest struct {
+	Servers     []projectQuotaServers
+	FloatingIPs int
+	// DBaaS is set for servers of the database service, they are also counted against its quotas.
+	DBaaS bool
+}
+
+// projectQuotaUsage is consumption of resources keyed by quota categories and resource names.
+type projectQuotaUsage map[string]map[string]int
+
+func (u projectQuotaUsage) add(category, resource string, amount int) {
+	if u[category] == nil {
+		u[category] = make(map[string]int)
+	}
+	u[category][resource] += amount
+}
+
+// projectQuotaRequestUsage returns resources consumed by the request. Flavors are retrieved to count vCPUs and RAM,
+// servers of unknown flavors are only counted as instances.
+func projectQuotaRequestUsage(computeClient *gophercloud.ServiceClient, r projectQuotaRequest) (projectQuotaUsage, error) {
+	usage := make(projectQuotaUsage)
+	for _, s := range r.Servers {
+		if s.Count <= 0 {
+			continue
+		}
+		usage.add(quotaCategoryCompute, "instances", s.Count)
+		if s.FlavorID != "" {
+			flavor, err := flavors.Get(computeClient, s.FlavorID).Extract()
+			if err != nil {
+				return nil, fmt.Errorf("error retrieving flavor %s: %s", s.FlavorID, err)
+			}
+			usage.add(quotaCategoryCompute, "cores", s.Count*flavor.VCPUs)
+			usage.add(quotaCategoryCompute, "ram", s.Count*flavor.RAM)
+		}
+		for _, size := range s.VolumeSizes {
+			usage.add(quotaCategoryVolume, "volumes", s.Count)
+			usage.add(quotaCategoryVolume, "gigabytes", s.Count*size)
+			if r.DBaaS {
+				usage.add(quotaCategoryDBaaS, "volumes", s.Count*size)
+			}
+		}
+		if r.DBaaS {
+			usage.add(quotaCategoryDBaaS, "instances", s.Count)
+		}
+	}
+	if r.FloatingIPs > 0 {
+		usage.add(quotaCategoryNetwork, "floatingip", r.FloatingIPs)
+	}
+	return usage, nil
+}
+
+// sub returns the usage reduced by the other usage, only positive amounts are kept.
+func (u projectQuotaUsage) sub(other projectQuotaUsage) projectQuotaUsage {
+	result := make(projectQuotaUsage)
+	for category, resources := range u {
+		for resource, amount := range resources {
+			if delta := amount - other[category][resource]; delta > 0 {
+				result.add(category, resource, delta)
+			}
+		}
+	}
+	return result
+}
+
+// checkProjectQuotas returns descriptions of the quotas the requested usage exceeds.
+func checkProjectQuotas(allQuotas map[string]projectQuotas, requested projectQuotaUsage) []string {
+	var exceeded []string
+	for category, resources := range requested {
+		for resource, amount := range resources {
+			q, ok := allQuotas[category][resource]
+			if !ok || q.Limit < 0 || q.Used+amount <= q.Limit {
+				continue
+			}
+			exceeded = append(exceeded, fmt.Sprintf("%s %s: requested %d, %d of %d in use",
+				category, resource, amount, q.Used, q.Limit))
+		}
+	}
+	sort.Strings(exceeded)
+	return exceeded
+}
+
+// getProjectQuotas returns quotas of the categories.
+func getProjectQuotas(config configer, region string, categories ...string) (map[string]projectQuotas, error) {
+	allQuotas := make(map[string]projectQuotas, len(categories))
+	for _, category := range categories {
+		var q projectQuotas
+		switch category {
+		case quotaCategoryCompute:
+			client, err := config.ComputeV2Client(region)
+			if err != nil {
+				return nil, fmt.Errorf("error creating compute client: %s", err)
+			}
+			if q, err = computeProjectQuotas(client); err != nil {
+				return nil, fmt.Errorf("error retrieving compute quotas: %s", err)
+			}
+		case quotaCategoryVolume:
+			client, err := config.BlockStorageV3Client(region)
+			if err != nil {
+				return nil, fmt.Errorf("error creating block storage client: %s", err)
+			}
+			if q, err = blockStorageProjectQuotas(client, config.GetProjectID()); err != nil {
+				return nil, fmt.Errorf("error retrieving volume quotas: %s", err)
+			}
+		case quotaCategoryNetwork:
+			client, err := config.NetworkingV2Client(region)
+			if err != nil {
+				return nil, fmt.Errorf("error creating networking client: %s", err)
+			}
+			if q, err = networkingProjectQuotas(client, config.GetProjectID()); err != nil {
+				return nil, fmt.Errorf("error retrieving network quotas: %s", err)
+			}
+		case quotaCategoryDBaaS:
+			client, err := config.DatabaseV1Client(region)
+			if err != nil {
+				return nil, fmt.Errorf("error creating database client: %s", err)
+			}
+			if q, err = databaseProjectQuotas(client); err != nil {
+				return nil, fmt.Errorf("error retrieving database quotas: %s", err)
+			}
+		}
+		allQuotas[category] = q
+	}
+	return allQuotas, nil
+}
+
+// customizeDiffQuotaPreflight checks on plan that resources requested by the resource fit into project quotas.
+// The check is enabled by quota_preflight argument of the provider. In error mode exceeded quotas and failures
+// to retrieve them fail the plan, in warn mode both are only logged as warnings.
+// The request function reads the resource attributes with the getter passed to it.
+func customizeDiffQuotaPreflight(request func(get func(key string) interface{}) projectQuotaRequest) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		config := meta.(configer)
+		mode := config.GetQuotaPreflight()
+		if mode == "" {
+			return nil
+		}
+
+		exceeded, err := exceededProjectQuotas(d, config, request)
+		if err != nil {
+			if mode == quotaPreflightError {
+				return err
+			}
+			log.Printf("[WARN] unable to check project quotas: %s", err)
+			return nil
+		}
+		if len(exceeded) == 0 {
+			return nil
+		}
+		msg := fmt.Sprintf("the request would exceed project quotas: %s", strings.Join(exceeded, "; "))
+		if mode == quotaPreflightError {
+			return fmt.Errorf("%s", msg)
+		}
+		log.Printf("[WARN] %s", msg)
+		return nil
+	}
+}
+
+// exceededProjectQuotas returns descriptions of the quotas exceeded by the change of resources requested
+// by the resource.
+func exceededProjectQuotas(d *schema.ResourceDiff, config configer, request func(get func(key string) interface{}) projectQuotaRequest) ([]string, error) {
+	newRequest := request(d.Get)
+	var oldRequest projectQuotaRequest
+	if d.Id() != "" {
+		oldRequest = request(func(key string) interface{} {
+			old, _ := d.GetChange(key)
+			return old
+		})
+	}
+	if reflect.DeepEqual(newRequest, oldRequest) {
+		return nil, nil
+	}
+
+	region := getRegion(d, config)
+	computeClient, err := config.ComputeV2Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating compute client: %s", err)
+	}
+	newUsage, err := projectQuotaRequestUsage(computeClient, newRequest)
+	if err != nil {
+		return nil, err
+	}
+	oldUsage, err := projectQuotaRequestUsage(computeClient, oldRequest)
+	if err != nil {
+		return nil, err
+	}
+	requested := newUsage.sub(oldUsage)
+	if len(requested) == 0 {
+		return nil, nil
+	}
+
+	categories := make([]string, 0, len(requested))
+	for category := range requested {
+		categories = append(categories, category)
+	}
+	allQuotas, err := getProjectQuotas(config, region, categories...)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkProjectQuotas(allQuotas, requested), nil
+}
+
+func quotaRequestWalVolumeSizes(volumeSize interface{}, walVolume interface{}) []int {
+	sizes := []int{volumeSize.(int)}
+	if walVolumes, ok := walVolume.([]interface{}); ok && len(walVolumes) > 0 && walVolumes[0] != nil {
+		sizes = append(sizes, walVolumes[0].(map[string]interface{})["size"].(int))
+	}
+	return sizes
+}
+
+func kubernetesClusterQuotaRequest(get func(key string) interface{}) projectQuotaRequest {
+	return projectQuotaRequest{
+		Servers: []projectQuotaServers{{
+			FlavorID: get("master_flavor").(string),
+			Count:    get("master_count").(int),
+		}},
+	}
+}
+
+func kubernetesNodeGroupQuotaRequest(get func(key string) interface{}) projectQuotaRequest {
+	return projectQuotaRequest{
+		Servers: []projectQuotaServers{{
+			FlavorID:    get("flavor_id").(string),
+			Count:       get("node_count").(int),
+			VolumeSizes: []int{get("volume_size").(int)},
+		}},
+	}
+}
+
+func databaseInstanceQuotaRequest(get func(key string) interface{}) projectQuotaRequest {
+	r := projectQuotaRequest{
+		Servers: []projectQuotaServers{{
+			FlavorID:    get("flavor_id").(string),
+			Count:       1,
+			VolumeSizes: quotaRequestWalVolumeSizes(get("size"), get("wal_volume")),
+		}},
+		DBaaS: true,
+	}
+	if get("floating_ip_enabled").(bool) {
+		r.FloatingIPs = 1
+	}
+	return r
+}
+
+func databaseClusterQuotaRequest(get func(key string) interface{}) projectQuotaRequest {
+	size := get("cluster_size").(int)
+	r := projectQuotaRequest{
+		Servers: []projectQuotaServers{{
+			FlavorID:    get("flavor_id").(string),
+			Count:       size,
+			VolumeSizes: quotaRequestWalVolumeSizes(get("volume_size"), get("wal_volume")),
+		}},
+		DBaaS: true,
+	}
+	if get("floating_ip_enabled").(bool) {
+		r.FloatingIPs = size
+	}
+	return r
+}
+
+func databaseClusterWithShardsQuotaRequest(get func(key string) interface{}) projectQuotaRequest {
+	r := projectQuotaRequest{DBaaS: true}
+	for _, s := range get("shard").([]interface{}) {
+		if s == nil {
+			continue
+		}
+		shard := s.(map[string]interface{})
+		r.Servers = append(r.Servers, projectQuotaServers{
+			FlavorID:    shard["flavor_id"].(string),
+			Count:       shard["size"].(int),
+			VolumeSizes: quotaRequestWalVolumeSizes(shard["volume_size"], shard["wal_volume"]),
+		})
+	}
+	if get("floating_ip_enabled").(bool) {
+		for _, s := range r.Servers {
+			r.FloatingIPs += s.Count
+		}
+	}
+	return r
+}
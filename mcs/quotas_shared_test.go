@@ -0,0 +1,205 @@
+package mcs
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"os"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectQuotaRequestUsage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	config := &dummyConfig{}
+	setupProjectQuotasFixtures(t, config)
+
+	d := schema.TestResourceDataRaw(t, resourceDatabaseInstance().Schema, map[string]interface{}{
+		"flavor_id":           "flavor-medium",
+		"size":                30,
+		"wal_volume":          []interface{}{map[string]interface{}{"size": 10, "volume_type": "ceph-ssd"}},
+		"floating_ip_enabled": true,
+	})
+	request := databaseInstanceQuotaRequest(d.Get)
+	assert.Equal(t, []int{30, 10}, request.Servers[0].VolumeSizes)
+
+	computeClient, _ := config.ComputeV2Client("RegionOne")
+	newUsage, err := projectQuotaRequestUsage(computeClient, request)
+	assert.NoError(t, err)
+	oldUsage, err := projectQuotaRequestUsage(computeClient, projectQuotaRequest{
+		Servers: []projectQuotaServers{{Count: 1, VolumeSizes: []int{30}}},
+		DBaaS:   true,
+	})
+	assert.NoError(t, err)
+
+	requested := newUsage.sub(oldUsage)
+	assert.Equal(t, projectQuotaUsage{
+		quotaCategoryCompute: {"cores": 2, "ram": 4096},
+		quotaCategoryVolume:  {"volumes": 1, "gigabytes": 10},
+		quotaCategoryNetwork: {"floatingip": 1},
+		quotaCategoryDBaaS:   {"volumes": 10},
+	}, requested)
+
+	allQuotas, err := getProjectQuotas(config, "RegionOne", projectQuotasCategories...)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"compute cores: requested 2, 19 of 20 in use",
+		"network floatingip: requested 1, 3 of 3 in use",
+	}, checkProjectQuotas(allQuotas, requested))
+}
+
+func TestCustomizeDiffQuotaPreflight(t *testing.T) {
+	res := &schema.Resource{
+		Schema:        resourceDatabaseInstance().Schema,
+		CustomizeDiff: customizeDiffQuotaPreflight(databaseInstanceQuotaRequest),
+	}
+	raw := map[string]interface{}{
+		"region":              "RegionOne",
+		"flavor_id":           "flavor-medium",
+		"size":                30,
+		"floating_ip_enabled": true,
+	}
+	state := &terraform.InstanceState{
+		ID: "instance",
+		Attributes: map[string]string{
+			"id":                   "instance",
+			"region":               "RegionOne",
+			"flavor_id":            "flavor-medium",
+			"size":                 "30",
+			"wal_volume.#":         "0",
+			"security_group_ids.#": "0",
+			"floating_ip_enabled":  "true",
+		},
+	}
+
+	tests := []struct {
+		name   string
+		mode   string
+		state  *terraform.InstanceState
+		size   int
+		errMsg string
+		warn   string
+	}{
+		{
+			name: "disabled",
+			size: 30,
+		},
+		{
+			name:   "error on create",
+			mode:   quotaPreflightError,
+			size:   30,
+			errMsg: "the request would exceed project quotas: compute cores: requested 2, 19 of 20 in use; network floatingip: requested 1, 3 of 3 in use; volume gigabytes: requested 30, 480 of 500 in use",
+		},
+		{
+			name: "warn on create",
+			mode: quotaPreflightWarn,
+			size: 30,
+			warn: "[WARN] the request would exceed project quotas: compute cores: requested 2, 19 of 20 in use; network floatingip: requested 1, 3 of 3 in use; volume gigabytes: requested 30, 480 of 500 in use",
+		},
+		{
+			name:  "unchanged",
+			mode:  quotaPreflightError,
+			state: state,
+			size:  30,
+		},
+		{
+			name:  "volume resize within quotas",
+			mode:  quotaPreflightError,
+			state: state,
+			size:  40,
+		},
+		{
+			name:   "volume resize exceeding quotas",
+			mode:   quotaPreflightError,
+			state:  state,
+			size:   60,
+			errMsg: "the request would exceed project quotas: volume gigabytes: requested 30, 480 of 500 in use",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th.SetupHTTP()
+			defer th.TeardownHTTP()
+
+			// Clients are only mocked when the check is expected to query the API.
+			config := &dummyConfig{}
+			config.On("GetQuotaPreflight").Return(tt.mode)
+			if tt.mode != "" && (tt.state == nil || tt.size != 30) {
+				setupProjectQuotasFixtures(t, config)
+			}
+
+			var logs bytes.Buffer
+			log.SetOutput(&logs)
+			defer log.SetOutput(os.Stderr)
+
+			raw["size"] = tt.size
+			_, err := res.Diff(tt.state, terraform.NewResourceConfigRaw(raw), config)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+			if tt.warn != "" {
+				assert.Contains(t, logs.String(), tt.warn)
+			} else {
+				assert.NotContains(t, logs.String(), "[WARN]")
+			}
+		})
+	}
+}
+
+func TestCustomizeDiffQuotaPreflightRetrievalError(t *testing.T) {
+	res := &schema.Resource{
+		Schema:        resourceDatabaseInstance().Schema,
+		CustomizeDiff: customizeDiffQuotaPreflight(databaseInstanceQuotaRequest),
+	}
+	raw := map[string]interface{}{
+		"region":    "RegionOne",
+		"flavor_id": "flavor-medium",
+		"size":      30,
+	}
+
+	for _, mode := range []string{quotaPreflightWarn, quotaPreflightError} {
+		t.Run(mode, func(t *testing.T) {
+			th.SetupHTTP()
+			defer th.TeardownHTTP()
+
+			handleFixture(t, http.MethodGet, "/limits", http.StatusInternalServerError, `{}`)
+			handleGetFixture(t, "/os-quota-sets/project", blockStorageQuotaUsageFixture)
+			handleGetFixture(t, "/db/limits", databaseLimitsFixture)
+			handleGetFixture(t, "/db/instances", databaseInstanceListFixture)
+			handleGetFixture(t, "/flavors/flavor-medium", `{"flavor": {"id": "flavor-medium", "vcpus": 2, "ram": 4096}}`)
+
+			databaseClient := fake.ServiceClient()
+			databaseClient.Endpoint = th.Endpoint() + "db/"
+
+			config := &dummyConfig{}
+			config.On("GetQuotaPreflight").Return(mode)
+			config.On("ComputeV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+			config.On("BlockStorageV3Client", "RegionOne").Return(fake.ServiceClient(), nil)
+			config.On("DatabaseV1Client", "RegionOne").Return(databaseClient, nil)
+			config.On("GetProjectID").Return("project")
+
+			var logs bytes.Buffer
+			log.SetOutput(&logs)
+			defer log.SetOutput(os.Stderr)
+
+			_, err := res.Diff(nil, terraform.NewResourceConfigRaw(raw), config)
+			if mode == quotaPreflightError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "error retrieving compute quotas")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Contains(t, logs.String(), "[WARN] unable to check project quotas: error retrieving compute quotas")
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/snapshots"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceBlockStorageSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageSnapshotCreate,
+		Read:   resourceBlockStorageSnapshotRead,
+		Update: resourceBlockStorageSnapshotUpdate,
+		Delete: resourceBlockStorageSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(blockStorageCreateTimeout * time.Minute),
+			Delete: schema.DefaultTimeout(blockStorageDeleteTimeout * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	blockStorageClient, err := config.BlockStorageV3Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating block storage client: %s", err)
+	}
+
+	createOpts := snapshots.CreateOpts{
+		VolumeID:    d.Get("volume_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Force:       d.Get("force").(bool),
+	}
+
+	log.Printf("[DEBUG] mcs_blockstorage_snapshot create options: %#v", createOpts)
+
+	s, err := snapshots.Create(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_blockstorage_snapshot: %s", err)
+	}
+
+	d.SetId(s.ID)
+
+	err = waitForBlockStorage(blockStorageSnapshotStateRefreshFunc(blockStorageClient, s.ID),
+		[]string{blockStorageStatusCreating},
+		[]string{blockStorageStatusAvailable}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error waiting for mcs_blockstorage_snapshot %s to become ready: %s", s.ID, err)
+	}
+
+	log.Printf("[DEBUG] Created mcs_blockstorage_snapshot %s", s.ID)
+	return resourceBlockStorageSnapshotRead(d, meta)
+}
+
+func resourceBlockStorageSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	blockStorageClient, err := config.BlockStorageV3Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating block storage client: %s", err)
+	}
+
+	s, err := snapshots.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_blockstorage_snapshot")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_blockstorage_snapshot %s: %#v", d.Id(), s)
+
+	d.Set("region", getRegion(d, config))
+	d.Set("volume_id", s.VolumeID)
+	d.Set("name", s.Name)
+	d.Set("description", s.Description)
+	d.Set("size", s.Size)
+
+	return nil
+}
+
+func resourceBlockStorageSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	blockStorageClient, err := config.BlockStorageV3Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating block storage client: %s", err)
+	}
+
+	var updateOpts snapshots.UpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	log.Printf("[DEBUG] mcs_blockstorage_snapshot %s update options: %#v", d.Id(), updateOpts)
+
+	if _, err := snapshots.Update(blockStorageClient, d.Id(), updateOpts).Extract(); err != nil {
+		return fmt.Errorf("error updating mcs_blockstorage_snapshot %s: %s", d.Id(), err)
+	}
+
+	return resourceBlockStorageSnapshotRead(d, meta)
+}
+
+func resourceBlockStorageSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	blockStorageClient, err := config.BlockStorageV3Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating block storage client: %s", err)
+	}
+
+	err = snapshots.Delete(blockStorageClient, d.Id()).ExtractErr()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error deleting mcs_blockstorage_snapshot %s: %s", d.Id(), err)
+	}
+
+	err = waitForBlockStorage(blockStorageSnapshotStateRefreshFunc(blockStorageClient, d.Id()),
+		[]string{blockStorageStatusAvailable, blockStorageStatusDeleting},
+		[]string{blockStorageStatusDeleted}, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("error waiting for mcs_blockstorage_snapshot %s to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,73 @@
+package mcs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceBlockStorageSnapshotRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/snapshots/snapshot", fmt.Sprintf(blockStorageSnapshotGetFixture, "db-data"))
+
+	config := &dummyConfig{}
+	config.On("BlockStorageV3Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceBlockStorageSnapshot().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("snapshot")
+
+	assert.NoError(t, resourceBlockStorageSnapshotRead(d, config))
+	assert.Equal(t, "volume", d.Get("volume_id"))
+	assert.Equal(t, "db-data", d.Get("name"))
+	assert.Equal(t, "before upgrade", d.Get("description"))
+	assert.Equal(t, 10, d.Get("size"))
+
+	d.SetId("missing")
+	assert.NoError(t, resourceBlockStorageSnapshotRead(d, config))
+	assert.Empty(t, d.Id())
+}
+
+func TestResourceBlockStorageSnapshotUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	name := "db-data"
+	th.Mux.HandleFunc("/snapshots/snapshot", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			var body map[string]map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"name": "db-backup"}, body["snapshot"])
+			name = body["snapshot"]["name"].(string)
+		}
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, blockStorageSnapshotGetFixture, name)
+	})
+
+	config := &dummyConfig{}
+	config.On("BlockStorageV3Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := testResourceDataUpdate(t, resourceBlockStorageSnapshot(), "snapshot", map[string]string{
+		"region":      "RegionOne",
+		"volume_id":   "volume",
+		"name":        "db-data",
+		"description": "before upgrade",
+	}, map[string]interface{}{
+		"region":      "RegionOne",
+		"volume_id":   "volume",
+		"name":        "db-backup",
+		"description": "before upgrade",
+	})
+
+	assert.NoError(t, resourceBlockStorageSnapshotUpdate(d, config))
+	assert.Equal(t, "db-backup", d.Get("name"))
+}
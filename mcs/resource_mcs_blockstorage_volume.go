@@ -0,0 +1,241 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumeactions"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceBlockStorageVolume() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeCreate,
+		Read:   resourceBlockStorageVolumeRead,
+		Update: resourceBlockStorageVolumeUpdate,
+		Delete: resourceBlockStorageVolumeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(blockStorageCreateTimeout * time.Minute),
+			Update: schema.DefaultTimeout(blockStorageUpdateTimeout * time.Minute),
+			Delete: schema.DefaultTimeout(blockStorageDeleteTimeout * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("size", func(old, new, meta interface{}) bool {
+				return new.(int) < old.(int)
+			}),
+			customizeDiffVolumeTypesExist("volume_type"),
+		),
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     false,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"volume_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"snapshot_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_vol_id", "image_id"},
+			},
+			"source_vol_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_id", "image_id"},
+			},
+			"image_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_id", "source_vol_id"},
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	blockStorageClient, err := config.BlockStorageV3Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating block storage client: %s", err)
+	}
+
+	createOpts := volumes.CreateOpts{
+		Size:             d.Get("size").(int),
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		VolumeType:       d.Get("volume_type").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+		SnapshotID:       d.Get("snapshot_id").(string),
+		SourceVolID:      d.Get("source_vol_id").(string),
+		ImageID:          d.Get("image_id").(string),
+		Metadata:         expandToMapStringString(d.Get("metadata").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] mcs_blockstorage_volume create options: %#v", createOpts)
+
+	v, err := volumes.Create(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_blockstorage_volume: %s", err)
+	}
+
+	d.SetId(v.ID)
+
+	err = waitForBlockStorage(blockStorageVolumeStateRefreshFunc(blockStorageClient, v.ID),
+		[]string{blockStorageStatusCreating},
+		[]string{blockStorageStatusAvailable}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error waiting for mcs_blockstorage_volume %s to become ready: %s", v.ID, err)
+	}
+
+	log.Printf("[DEBUG] Created mcs_blockstorage_volume %s", v.ID)
+	return resourceBlockStorageVolumeRead(d, meta)
+}
+
+func resourceBlockStorageVolumeRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	blockStorageClient, err := config.BlockStorageV3Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating block storage client: %s", err)
+	}
+
+	v, err := volumes.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_blockstorage_volume")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_blockstorage_volume %s: %#v", d.Id(), v)
+
+	d.Set("region", getRegion(d, config))
+	d.Set("size", v.Size)
+	d.Set("name", v.Name)
+	d.Set("description", v.Description)
+	d.Set("volume_type", v.VolumeType)
+	d.Set("availability_zone", v.AvailabilityZone)
+	d.Set("snapshot_id", v.SnapshotID)
+	d.Set("source_vol_id", v.SourceVolID)
+	d.Set("metadata", v.Metadata)
+
+	return nil
+}
+
+func resourceBlockStorageVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	blockStorageClient, err := config.BlockStorageV3Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating block storage client: %s", err)
+	}
+
+	if d.HasChanges("name", "description", "metadata") {
+		var updateOpts volumes.UpdateOpts
+		if d.HasChange("name") {
+			name := d.Get("name").(string)
+			updateOpts.Name = &name
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateOpts.Description = &description
+		}
+		if d.HasChange("metadata") {
+			updateOpts.Metadata = expandToMapStringString(d.Get("metadata").(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] mcs_blockstorage_volume %s update options: %#v", d.Id(), updateOpts)
+
+		if _, err := volumes.Update(blockStorageClient, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("error updating mcs_blockstorage_volume %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("size") {
+		extendOpts := volumeactions.ExtendSizeOpts{
+			NewSize: d.Get("size").(int),
+		}
+		if err := volumeactions.ExtendSize(blockStorageClient, d.Id(), extendOpts).ExtractErr(); err != nil {
+			return fmt.Errorf("error extending mcs_blockstorage_volume %s: %s", d.Id(), err)
+		}
+
+		err = waitForBlockStorage(blockStorageVolumeStateRefreshFunc(blockStorageClient, d.Id()),
+			[]string{blockStorageStatusExtending},
+			[]string{blockStorageStatusAvailable, blockStorageStatusInUse}, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("error waiting for mcs_blockstorage_volume %s to extend: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBlockStorageVolumeRead(d, meta)
+}
+
+func resourceBlockStorageVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	blockStorageClient, err := config.BlockStorageV3Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating block storage client: %s", err)
+	}
+
+	err = volumes.Delete(blockStorageClient, d.Id(), volumes.DeleteOpts{}).ExtractErr()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error deleting mcs_blockstorage_volume %s: %s", d.Id(), err)
+	}
+
+	err = waitForBlockStorage(blockStorageVolumeStateRefreshFunc(blockStorageClient, d.Id()),
+		[]string{blockStorageStatusAvailable, blockStorageStatusDeleting},
+		[]string{blockStorageStatusDeleted}, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("error waiting for mcs_blockstorage_volume %s to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,33 @@
+package mcs
+
+import (
+	"fmt"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceBlockStorageVolumeRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/volumes/volume", fmt.Sprintf(blockStorageVolumeGetFixture, "available"))
+
+	config := &dummyConfig{}
+	config.On("BlockStorageV3Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceBlockStorageVolume().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("volume")
+
+	assert.NoError(t, resourceBlockStorageVolumeRead(d, config))
+	assert.Equal(t, "data", d.Get("name"))
+	assert.Equal(t, 20, d.Get("size"))
+	assert.Equal(t, "ceph-ssd", d.Get("volume_type"))
+	assert.Equal(t, "MS1", d.Get("availability_zone"))
+	assert.Equal(t, map[string]interface{}{"purpose": "data"}, d.Get("metadata"))
+}
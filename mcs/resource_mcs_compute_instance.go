@@ -0,0 +1,446 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/bootfromvolume"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/secgroups"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/startstop"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceComputeInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeInstanceCreate,
+		Read:   resourceComputeInstanceRead,
+		Update: resourceComputeInstanceUpdate,
+		Delete: resourceComputeInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(computeInstanceCreateTimeout * time.Minute),
+			Update: schema.DefaultTimeout(computeInstanceUpdateTimeout * time.Minute),
+			Delete: schema.DefaultTimeout(computeInstanceDeleteTimeout * time.Minute),
+		},
+
+		CustomizeDiff: customizeDiffKeypairExists,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"keypair": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"user_data": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"network": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"fixed_ip_v4": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+						"mac": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"block_device": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"source_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(bootfromvolume.SourceBlank), string(bootfromvolume.SourceImage),
+								string(bootfromvolume.SourceSnapshot), string(bootfromvolume.SourceVolume),
+							}, false),
+						},
+						"destination_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(bootfromvolume.DestinationLocal), string(bootfromvolume.DestinationVolume),
+							}, false),
+						},
+						"volume_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"volume_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"boot_index": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"delete_on_termination": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"power_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  computeInstancePowerStateActive,
+				ForceNew: false,
+				ValidateFunc: validation.StringInSlice([]string{
+					computeInstancePowerStateActive, computeInstancePowerStateShutoff,
+				}, false),
+			},
+			"access_ip_v4": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandComputeInstanceNetworks(v []interface{}) []servers.Network {
+	networks := make([]servers.Network, 0, len(v))
+	for _, n := range v {
+		network := n.(map[string]interface{})
+		networks = append(networks, servers.Network{
+			UUID:    network["uuid"].(string),
+			Port:    network["port"].(string),
+			FixedIP: network["fixed_ip_v4"].(string),
+		})
+	}
+	return networks
+}
+
+func expandComputeInstanceBlockDevices(v []interface{}) []bootfromvolume.BlockDevice {
+	blockDevices := make([]bootfromvolume.BlockDevice, 0, len(v))
+	for i, b := range v {
+		blockDevice := b.(map[string]interface{})
+		// Boot index is always sent, unset boot index of devices after the first one means not bootable.
+		bootIndex := blockDevice["boot_index"].(int)
+		if bootIndex == 0 && i > 0 {
+			bootIndex = -1
+		}
+		blockDevices = append(blockDevices, bootfromvolume.BlockDevice{
+			UUID:                blockDevice["uuid"].(string),
+			SourceType:          bootfromvolume.SourceType(blockDevice["source_type"].(string)),
+			DestinationType:     bootfromvolume.DestinationType(blockDevice["destination_type"].(string)),
+			VolumeSize:          blockDevice["volume_size"].(int),
+			VolumeType:          blockDevice["volume_type"].(string),
+			BootIndex:           bootIndex,
+			DeleteOnTermination: blockDevice["delete_on_termination"].(bool),
+		})
+	}
+	return blockDevices
+}
+
+// computeInstanceSetPowerState starts or stops the instance and waits for the power state.
+func computeInstanceSetPowerState(client *gophercloud.ServiceClient, id, powerState string, timeout time.Duration) error {
+	if powerState == computeInstancePowerStateShutoff {
+		if err := startstop.Stop(client, id).ExtractErr(); err != nil {
+			return fmt.Errorf("error stopping compute instance %s: %s", id, err)
+		}
+		return waitForComputeInstance(client, id,
+			[]string{computeInstanceStatusActive},
+			[]string{computeInstanceStatusShutoff}, timeout)
+	}
+
+	if err := startstop.Start(client, id).ExtractErr(); err != nil {
+		return fmt.Errorf("error starting compute instance %s: %s", id, err)
+	}
+	return waitForComputeInstance(client, id,
+		[]string{computeInstanceStatusShutoff},
+		[]string{computeInstanceStatusActive}, timeout)
+}
+
+func resourceComputeInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	computeClient, err := config.ComputeV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating compute client: %s", err)
+	}
+
+	serverCreateOpts := servers.CreateOpts{
+		Name:             d.Get("name").(string),
+		FlavorRef:        d.Get("flavor_id").(string),
+		ImageRef:         d.Get("image_id").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+		SecurityGroups:   expandToStringSlice(d.Get("security_groups").(*schema.Set).List()),
+		Networks:         expandComputeInstanceNetworks(d.Get("network").([]interface{})),
+	}
+	if userData := d.Get("user_data").(string); userData != "" {
+		serverCreateOpts.UserData = []byte(userData)
+	}
+
+	var createOpts servers.CreateOptsBuilder = serverCreateOpts
+	if keypair := d.Get("keypair").(string); keypair != "" {
+		createOpts = keypairs.CreateOptsExt{
+			CreateOptsBuilder: createOpts,
+			KeyName:           keypair,
+		}
+	}
+	if v := d.Get("block_device").([]interface{}); len(v) > 0 {
+		createOpts = bootfromvolume.CreateOptsExt{
+			CreateOptsBuilder: createOpts,
+			BlockDevice:       expandComputeInstanceBlockDevices(v),
+		}
+	}
+
+	log.Printf("[DEBUG] mcs_compute_instance create options: %#v", createOpts)
+
+	s, err := servers.Create(computeClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_compute_instance: %s", err)
+	}
+
+	d.SetId(s.ID)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	err = waitForComputeInstance(computeClient, s.ID,
+		[]string{computeInstanceStatusBuild},
+		[]string{computeInstanceStatusActive}, timeout)
+	if err != nil {
+		return err
+	}
+
+	if powerState := d.Get("power_state").(string); powerState != computeInstancePowerStateActive {
+		if err := computeInstanceSetPowerState(computeClient, s.ID, powerState, timeout); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Created mcs_compute_instance %s", s.ID)
+	return resourceComputeInstanceRead(d, meta)
+}
+
+func resourceComputeInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	computeClient, err := config.ComputeV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating compute client: %s", err)
+	}
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	s, err := computeInstanceGet(computeClient, d.Id())
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_compute_instance")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_compute_instance %s: %#v", d.Id(), s)
+
+	instancePorts, err := computeInstancePorts(networkingClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("error retrieving ports of mcs_compute_instance %s: %s", d.Id(), err)
+	}
+	networks := flattenComputeInstanceNetworks(d.Get("network").([]interface{}), instancePorts)
+
+	d.Set("region", getRegion(d, config))
+	d.Set("name", s.Name)
+	d.Set("flavor_id", flattenComputeInstanceFlavorID(&s.Server))
+	d.Set("image_id", flattenComputeInstanceImageID(&s.Server))
+	d.Set("keypair", s.KeyName)
+	d.Set("availability_zone", s.AvailabilityZone)
+	d.Set("security_groups", flattenComputeInstanceSecurityGroups(&s.Server))
+	d.Set("network", networks)
+	if powerState, ok := computeInstancePowerState(s.Status); ok {
+		d.Set("power_state", powerState)
+	}
+	d.Set("access_ip_v4", flattenComputeInstanceAccessIPv4(&s.Server, networks))
+
+	return nil
+}
+
+func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	computeClient, err := config.ComputeV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating compute client: %s", err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.HasChange("name") {
+		updateOpts := servers.UpdateOpts{
+			Name: d.Get("name").(string),
+		}
+		if _, err := servers.Update(computeClient, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("error updating mcs_compute_instance %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("security_groups") {
+		o, n := d.GetChange("security_groups")
+		oldGroups, newGroups := o.(*schema.Set), n.(*schema.Set)
+
+		for _, name := range oldGroups.Difference(newGroups).List() {
+			if err := secgroups.RemoveServer(computeClient, d.Id(), name.(string)).ExtractErr(); err != nil {
+				if _, ok := err.(gophercloud.ErrDefault404); !ok {
+					return fmt.Errorf("error removing security group %s from mcs_compute_instance %s: %s", name, d.Id(), err)
+				}
+			}
+		}
+		for _, name := range newGroups.Difference(oldGroups).List() {
+			if err := secgroups.AddServer(computeClient, d.Id(), name.(string)).ExtractErr(); err != nil {
+				return fmt.Errorf("error adding security group %s to mcs_compute_instance %s: %s", name, d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("flavor_id") {
+		o, _ := d.GetChange("power_state")
+		status := computeInstanceStatusActive
+		if o.(string) == computeInstancePowerStateShutoff {
+			status = computeInstanceStatusShutoff
+		}
+
+		resizeOpts := servers.ResizeOpts{
+			FlavorRef: d.Get("flavor_id").(string),
+		}
+		if err := servers.Resize(computeClient, d.Id(), resizeOpts).ExtractErr(); err != nil {
+			return fmt.Errorf("error resizing mcs_compute_instance %s: %s", d.Id(), err)
+		}
+		err = waitForComputeInstance(computeClient, d.Id(),
+			[]string{status, computeInstanceStatusResize},
+			[]string{computeInstanceStatusVerifyResize}, timeout)
+		if err != nil {
+			return err
+		}
+
+		if err := servers.ConfirmResize(computeClient, d.Id()).ExtractErr(); err != nil {
+			return fmt.Errorf("error confirming resize of mcs_compute_instance %s: %s", d.Id(), err)
+		}
+		err = waitForComputeInstance(computeClient, d.Id(),
+			[]string{computeInstanceStatusVerifyResize},
+			[]string{status}, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("power_state") {
+		if err := computeInstanceSetPowerState(computeClient, d.Id(), d.Get("power_state").(string), timeout); err != nil {
+			return err
+		}
+	}
+
+	return resourceComputeInstanceRead(d, meta)
+}
+
+func resourceComputeInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	computeClient, err := config.ComputeV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating compute client: %s", err)
+	}
+
+	err = servers.Delete(computeClient, d.Id()).ExtractErr()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error deleting mcs_compute_instance %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{computeInstanceStatusActive, computeInstanceStatusShutoff, computeInstanceStatusError},
+		Target:     []string{computeInstanceStatusDeleted},
+		Refresh:    computeInstanceDeleteStateRefreshFunc(computeClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: computeInstanceMinTimeout * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for mcs_compute_instance %s to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,260 @@
+package mcs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeInstanceStateRefreshFunc(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/servers/active", fmt.Sprintf(computeInstanceGetFixture, "ACTIVE"))
+	handleGetFixture(t, "/servers/error", fmt.Sprintf(computeInstanceGetFixture, "ERROR"))
+
+	_, state, err := computeInstanceStateRefreshFunc(fake.ServiceClient(), "active")()
+	assert.NoError(t, err)
+	assert.Equal(t, "ACTIVE", state)
+
+	_, state, err = computeInstanceStateRefreshFunc(fake.ServiceClient(), "error")()
+	assert.EqualError(t, err, "there was an error provisioning the compute instance: No valid host was found.")
+	assert.Equal(t, "ERROR", state)
+
+	_, state, err = computeInstanceStateRefreshFunc(fake.ServiceClient(), "notfound")()
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETED", state)
+}
+
+func TestComputeInstanceDeleteStateRefreshFunc(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/servers/error", fmt.Sprintf(computeInstanceGetFixture, "ERROR"))
+
+	_, state, err := computeInstanceDeleteStateRefreshFunc(fake.ServiceClient(), "error")()
+	assert.NoError(t, err)
+	assert.Equal(t, "ERROR", state)
+
+	_, state, err = computeInstanceDeleteStateRefreshFunc(fake.ServiceClient(), "notfound")()
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETED", state)
+}
+
+func TestFlattenComputeInstanceNetworks(t *testing.T) {
+	instancePorts := []ports.Port{
+		{ID: "port-a", NetworkID: "net-a", MACAddress: "mac-a", FixedIPs: []ports.IP{{IPAddress: "fd00::1"}, {IPAddress: "10.0.0.1"}}},
+		{ID: "port-b", NetworkID: "net-b", MACAddress: "mac-b", FixedIPs: []ports.IP{{IPAddress: "10.0.1.1"}}},
+	}
+	portA := map[string]interface{}{"uuid": "net-a", "port": "port-a", "fixed_ip_v4": "10.0.0.1", "mac": "mac-a"}
+	portB := map[string]interface{}{"uuid": "net-b", "port": "port-b", "fixed_ip_v4": "10.0.1.1", "mac": "mac-b"}
+	unmatched := map[string]interface{}{"uuid": "net-c", "port": "", "fixed_ip_v4": "", "mac": ""}
+
+	cases := map[string]struct {
+		configured []interface{}
+		expected   []map[string]interface{}
+	}{
+		"imported": {
+			expected: []map[string]interface{}{portA, portB},
+		},
+		"by network": {
+			configured: []interface{}{
+				map[string]interface{}{"uuid": "net-b", "port": "", "fixed_ip_v4": "", "mac": ""},
+				map[string]interface{}{"uuid": "net-a", "port": "", "fixed_ip_v4": "", "mac": ""},
+			},
+			expected: []map[string]interface{}{portB, portA},
+		},
+		"by port": {
+			configured: []interface{}{
+				map[string]interface{}{"uuid": "", "port": "port-b", "fixed_ip_v4": "", "mac": ""},
+			},
+			expected: []map[string]interface{}{portB},
+		},
+		"unmatched": {
+			configured: []interface{}{unmatched},
+			expected:   []map[string]interface{}{unmatched},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, flattenComputeInstanceNetworks(tc.configured, instancePorts))
+		})
+	}
+}
+
+func TestExpandComputeInstanceBlockDevices(t *testing.T) {
+	blockDevices := expandComputeInstanceBlockDevices([]interface{}{
+		map[string]interface{}{
+			"uuid": "image", "source_type": "image", "destination_type": "volume", "volume_size": 10,
+			"volume_type": "ceph-ssd", "boot_index": 0, "delete_on_termination": true,
+		},
+		map[string]interface{}{
+			"uuid": "volume", "source_type": "volume", "destination_type": "volume", "volume_size": 0,
+			"volume_type": "", "boot_index": 0, "delete_on_termination": false,
+		},
+		map[string]interface{}{
+			"uuid": "", "source_type": "blank", "destination_type": "local", "volume_size": 1,
+			"volume_type": "", "boot_index": 1, "delete_on_termination": true,
+		},
+	})
+
+	assert.Len(t, blockDevices, 3)
+	assert.Equal(t, 0, blockDevices[0].BootIndex)
+	assert.Equal(t, -1, blockDevices[1].BootIndex)
+	assert.Equal(t, 1, blockDevices[2].BootIndex)
+
+	body, err := json.Marshal(blockDevices[1])
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `"boot_index":-1`)
+}
+
+func TestResourceComputeInstanceRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/servers/instance", fmt.Sprintf(computeInstanceGetFixture, "SHUTOFF"))
+	handleGetFixture(t, "/ports", computeInstancePortListFixture)
+
+	config := &dummyConfig{}
+	config.On("ComputeV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+	config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceComputeInstance().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("instance")
+
+	assert.NoError(t, resourceComputeInstanceRead(d, config))
+	assert.Equal(t, "bastion", d.Get("name"))
+	assert.Equal(t, "flavor", d.Get("flavor_id"))
+	assert.Equal(t, "image", d.Get("image_id"))
+	assert.Equal(t, "deployer", d.Get("keypair"))
+	assert.Equal(t, "MS1", d.Get("availability_zone"))
+	assert.Equal(t, "shutoff", d.Get("power_state"))
+	assert.Equal(t, 2, d.Get("security_groups").(*schema.Set).Len())
+	assert.Equal(t, "network", d.Get("network.0.uuid"))
+	assert.Equal(t, "192.168.0.30", d.Get("access_ip_v4"))
+}
+
+func TestResourceComputeInstanceReadError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/servers/instance", fmt.Sprintf(computeInstanceGetFixture, "ERROR"))
+	handleGetFixture(t, "/ports", computeInstancePortListFixture)
+
+	config := &dummyConfig{}
+	config.On("ComputeV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+	config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceComputeInstance().Schema, map[string]interface{}{
+		"region":      "RegionOne",
+		"power_state": "shutoff",
+	})
+	d.SetId("instance")
+
+	assert.NoError(t, resourceComputeInstanceRead(d, config))
+	assert.Equal(t, "shutoff", d.Get("power_state"))
+}
+
+func TestResourceComputeInstanceUpdate(t *testing.T) {
+	tests := map[string]struct {
+		powerState string
+		raw        map[string]interface{}
+		actions    []string
+	}{
+		"resize": {
+			powerState: "active",
+			raw:        map[string]interface{}{"flavor_id": "flavor-large"},
+			actions:    []string{"resize", "confirmResize"},
+		},
+		"resize shutoff": {
+			powerState: "shutoff",
+			raw:        map[string]interface{}{"flavor_id": "flavor-large", "power_state": "shutoff"},
+			actions:    []string{"resize", "confirmResize"},
+		},
+		"stop": {
+			powerState: "active",
+			raw:        map[string]interface{}{"power_state": "shutoff"},
+			actions:    []string{"os-stop"},
+		},
+		"resize and start": {
+			powerState: "shutoff",
+			raw:        map[string]interface{}{"flavor_id": "flavor-large"},
+			actions:    []string{"resize", "confirmResize", "os-start"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			th.SetupHTTP()
+			defer th.TeardownHTTP()
+
+			// The fake server moves through statuses of the actions immediately.
+			status := strings.ToUpper(tt.powerState)
+			statusBeforeResize := status
+			var actions []string
+			th.Mux.HandleFunc("/servers/instance/action", func(w http.ResponseWriter, r *http.Request) {
+				th.TestMethod(t, r, "POST")
+				var body map[string]interface{}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				for action := range body {
+					actions = append(actions, action)
+					switch action {
+					case "resize":
+						assert.Equal(t, map[string]interface{}{"flavorRef": "flavor-large"}, body[action])
+						statusBeforeResize, status = status, computeInstanceStatusVerifyResize
+					case "confirmResize":
+						status = statusBeforeResize
+					case "os-stop":
+						status = computeInstanceStatusShutoff
+					case "os-start":
+						status = computeInstanceStatusActive
+					}
+				}
+				w.WriteHeader(http.StatusAccepted)
+			})
+			th.Mux.HandleFunc("/servers/instance", func(w http.ResponseWriter, r *http.Request) {
+				th.TestMethod(t, r, "GET")
+				w.Header().Add("Content-Type", "application/json")
+				fmt.Fprintf(w, computeInstanceGetFixture, status)
+			})
+			handleGetFixture(t, "/ports", computeInstancePortListFixture)
+
+			config := &dummyConfig{}
+			config.On("ComputeV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+			config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+			raw := map[string]interface{}{
+				"region":    "RegionOne",
+				"name":      "bastion",
+				"flavor_id": "flavor",
+				"network":   []interface{}{map[string]interface{}{"uuid": "network"}},
+			}
+			for k, v := range tt.raw {
+				raw[k] = v
+			}
+			d := testResourceDataUpdate(t, resourceComputeInstance(), "instance", map[string]string{
+				"region":         "RegionOne",
+				"name":           "bastion",
+				"flavor_id":      "flavor",
+				"power_state":    tt.powerState,
+				"network.#":      "1",
+				"network.0.uuid": "network",
+			}, raw)
+
+			assert.NoError(t, resourceComputeInstanceUpdate(d, config))
+			assert.Equal(t, tt.actions, actions)
+			assert.Equal(t, strings.ToLower(status), d.Get("power_state"))
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceComputeKeypair() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeKeypairCreate,
+		Read:   resourceComputeKeypairRead,
+		Delete: resourceComputeKeypairDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"public_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"private_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeKeypairCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	computeClient, err := config.ComputeV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating compute client: %s", err)
+	}
+
+	createOpts := keypairs.CreateOpts{
+		Name:      d.Get("name").(string),
+		PublicKey: d.Get("public_key").(string),
+	}
+
+	log.Printf("[DEBUG] mcs_compute_keypair create options: %#v", createOpts)
+
+	kp, err := keypairs.Create(computeClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_compute_keypair: %s", err)
+	}
+
+	d.SetId(kp.Name)
+
+	// Private key is only returned when the keypair is generated.
+	d.Set("private_key", kp.PrivateKey)
+
+	log.Printf("[DEBUG] Created mcs_compute_keypair %s", kp.Name)
+	return resourceComputeKeypairRead(d, meta)
+}
+
+func resourceComputeKeypairRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	computeClient, err := config.ComputeV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating compute client: %s", err)
+	}
+
+	kp, err := keypairs.Get(computeClient, d.Id(), nil).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_compute_keypair")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_compute_keypair %s: %#v", d.Id(), kp)
+
+	d.Set("region", getRegion(d, config))
+	d.Set("name", kp.Name)
+	d.Set("public_key", kp.PublicKey)
+	d.Set("fingerprint", kp.Fingerprint)
+
+	return nil
+}
+
+func resourceComputeKeypairDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	computeClient, err := config.ComputeV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating compute client: %s", err)
+	}
+
+	err = keypairs.Delete(computeClient, d.Id(), nil).ExtractErr()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			return fmt.Errorf("error deleting mcs_compute_keypair %s: %s", d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
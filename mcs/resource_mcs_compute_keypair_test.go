@@ -0,0 +1,61 @@
+package mcs
+
+import (
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceComputeKeypairRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/os-keypairs/deployer", keypairGetFixture)
+
+	config := &dummyConfig{}
+	config.On("ComputeV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceComputeKeypair().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("deployer")
+	d.Set("private_key", "generated")
+
+	assert.NoError(t, resourceComputeKeypairRead(d, config))
+	assert.Equal(t, "deployer", d.Get("name"))
+	assert.Equal(t, "ee:7a:88:0d:3b:8c:2f:9b:31:59:0a:2c:0d:05:4f:21", d.Get("fingerprint"))
+	assert.Equal(t, "generated", d.Get("private_key"))
+}
+
+func TestDataSourceComputeKeypairRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/os-keypairs/deployer", keypairGetFixture)
+
+	config := &dummyConfig{}
+	config.On("ComputeV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, dataSourceComputeKeypair().Schema, map[string]interface{}{
+		"region": "RegionOne",
+		"name":   "deployer",
+	})
+
+	assert.NoError(t, dataSourceComputeKeypairRead(d, config))
+	assert.Equal(t, "deployer", d.Id())
+	assert.Contains(t, d.Get("public_key"), "ssh-rsa")
+}
+
+func TestCheckComputeKeypairExists(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/os-keypairs/deployer", keypairGetFixture)
+
+	assert.NoError(t, checkComputeKeypairExists(fake.ServiceClient(), "deployer", "RegionOne"))
+	assert.EqualError(t, checkComputeKeypairExists(fake.ServiceClient(), "missing", "RegionOne"),
+		`keypair "missing" does not exist in region RegionOne`)
+}
@@ -0,0 +1,163 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/volumeattach"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceComputeVolumeAttach() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeVolumeAttachCreate,
+		Read:   resourceComputeVolumeAttachRead,
+		Delete: resourceComputeVolumeAttachDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(blockStorageCreateTimeout * time.Minute),
+			Delete: schema.DefaultTimeout(blockStorageDeleteTimeout * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"device": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// parseComputeVolumeAttachID splits <instance_id>/<attachment_id> ID of the attachment.
+func parseComputeVolumeAttachID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid format specified for mcs_compute_volume_attach, format must be <instance_id>/<attachment_id>")
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceComputeVolumeAttachCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	computeClient, err := config.ComputeV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating compute client: %s", err)
+	}
+	blockStorageClient, err := config.BlockStorageV3Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating block storage client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	createOpts := volumeattach.CreateOpts{
+		VolumeID: d.Get("volume_id").(string),
+		Device:   d.Get("device").(string),
+	}
+
+	log.Printf("[DEBUG] mcs_compute_volume_attach create options: %#v", createOpts)
+
+	attachment, err := volumeattach.Create(computeClient, instanceID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_compute_volume_attach: %s", err)
+	}
+
+	id := fmt.Sprintf("%s/%s", instanceID, attachment.ID)
+	d.SetId(id)
+
+	err = waitForBlockStorage(blockStorageVolumeStateRefreshFunc(blockStorageClient, createOpts.VolumeID),
+		[]string{blockStorageStatusAvailable, blockStorageStatusAttaching},
+		[]string{blockStorageStatusInUse}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error waiting for mcs_compute_volume_attach %s to become ready: %s", id, err)
+	}
+
+	log.Printf("[DEBUG] Created mcs_compute_volume_attach %s", id)
+	return resourceComputeVolumeAttachRead(d, meta)
+}
+
+func resourceComputeVolumeAttachRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	computeClient, err := config.ComputeV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating compute client: %s", err)
+	}
+
+	instanceID, attachmentID, err := parseComputeVolumeAttachID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	attachment, err := volumeattach.Get(computeClient, instanceID, attachmentID).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_compute_volume_attach")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_compute_volume_attach %s: %#v", d.Id(), attachment)
+
+	d.Set("region", getRegion(d, config))
+	d.Set("instance_id", attachment.ServerID)
+	d.Set("volume_id", attachment.VolumeID)
+	d.Set("device", attachment.Device)
+
+	return nil
+}
+
+func resourceComputeVolumeAttachDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	computeClient, err := config.ComputeV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating compute client: %s", err)
+	}
+	blockStorageClient, err := config.BlockStorageV3Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating block storage client: %s", err)
+	}
+
+	instanceID, attachmentID, err := parseComputeVolumeAttachID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = volumeattach.Delete(computeClient, instanceID, attachmentID).ExtractErr()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error deleting mcs_compute_volume_attach %s: %s", d.Id(), err)
+	}
+
+	err = waitForBlockStorage(blockStorageVolumeStateRefreshFunc(blockStorageClient, d.Get("volume_id").(string)),
+		[]string{blockStorageStatusInUse, blockStorageStatusDetaching},
+		[]string{blockStorageStatusAvailable, blockStorageStatusDeleted}, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("error waiting for mcs_compute_volume_attach %s to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,34 @@
+package mcs
+
+import (
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceComputeVolumeAttachRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/servers/instance/os-volume_attachments/volume", computeVolumeAttachGetFixture)
+
+	config := &dummyConfig{}
+	config.On("ComputeV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceComputeVolumeAttach().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("instance/volume")
+
+	assert.NoError(t, resourceComputeVolumeAttachRead(d, config))
+	assert.Equal(t, "instance", d.Get("instance_id"))
+	assert.Equal(t, "volume", d.Get("volume_id"))
+	assert.Equal(t, "/dev/vdb", d.Get("device"))
+
+	d.SetId("instance")
+	assert.EqualError(t, resourceComputeVolumeAttachRead(d, config),
+		"invalid format specified for mcs_compute_volume_attach, format must be <instance_id>/<attachment_id>")
+}
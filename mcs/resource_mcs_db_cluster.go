@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -33,6 +34,13 @@ func resourceDatabaseCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(dbDeleteTimeout),
 		},
 
+		CustomizeDiff: customdiff.All(
+			customizeDiffTagsAll,
+			customizeDiffKeypairExists,
+			customizeDiffVolumeTypesExist("volume_type", "wal_volume"),
+			customizeDiffQuotaPreflight(databaseClusterQuotaRequest),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -239,6 +247,9 @@ func resourceDatabaseCluster() *schema.Resource {
 					},
 				},
 			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -253,6 +264,7 @@ func resourceDatabaseClusterCreate(d *schema.ResourceData, meta interface{}) err
 	createOpts := &dbClusterCreateOpts{
 		Name:              d.Get("name").(string),
 		FloatingIPEnabled: d.Get("floating_ip_enabled").(bool),
+		Metadata:          mergeTags(config.GetDefaultTags(), d.Get("tags").(map[string]interface{})),
 	}
 
 	message := "unable to determine mcs_db_instance"
@@ -382,6 +394,7 @@ func resourceDatabaseClusterRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("name", cluster.Name)
 	d.Set("datastore", cluster.DataStore)
+	readTags(d, config, cluster.Metadata)
 
 	return nil
 }
@@ -633,6 +646,14 @@ func resourceDatabaseClusterUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if d.HasChange("tags_all") {
+		tagsAll := expandTags(d.Get("tags_all").(map[string]interface{}))
+		err := tagsUpdate(DatabaseV1Client, metadataURL(DatabaseV1Client, dbClustersAPIPath, d.Id()), "metadata", tagsAll).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("error updating tags of mcs_db_cluster %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceDatabaseClusterRead(d, meta)
 }
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -20,6 +21,13 @@ func resourceDatabaseClusterWithShards() *schema.Resource {
 			Delete: schema.DefaultTimeout(dbDeleteTimeout),
 		},
 
+		CustomizeDiff: customdiff.All(
+			customizeDiffTagsAll,
+			customizeDiffKeypairExists,
+			customizeDiffVolumeTypesExist("shard"),
+			customizeDiffQuotaPreflight(databaseClusterWithShardsQuotaRequest),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -219,6 +227,9 @@ func resourceDatabaseClusterWithShards() *schema.Resource {
 					},
 				},
 			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -233,6 +244,7 @@ func resourceDatabaseClusterWithShardsCreate(d *schema.ResourceData, meta interf
 	createOpts := &dbClusterCreateOpts{
 		Name:              d.Get("name").(string),
 		FloatingIPEnabled: d.Get("floating_ip_enabled").(bool),
+		Metadata:          mergeTags(config.GetDefaultTags(), d.Get("tags").(map[string]interface{})),
 	}
 
 	message := "unable to determine mcs_db_cluster"
@@ -355,6 +367,7 @@ func resourceDatabaseClusterWithShardsRead(d *schema.ResourceData, meta interfac
 
 	d.Set("name", cluster.Name)
 	d.Set("datastore", cluster.DataStore)
+	readTags(d, config, cluster.Metadata)
 
 	return nil
 }
@@ -450,6 +463,14 @@ func resourceDatabaseClusterWithShardsUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	if d.HasChange("tags_all") {
+		tagsAll := expandTags(d.Get("tags_all").(map[string]interface{}))
+		err := tagsUpdate(DatabaseV1Client, metadataURL(DatabaseV1Client, dbClustersAPIPath, d.Id()), "metadata", tagsAll).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("error updating tags of mcs_db_cluster_with_shards %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceDatabaseClusterRead(d, meta)
 }
 
@@ -58,6 +58,8 @@ func resourceDatabaseDatabase() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -172,6 +174,13 @@ func resourceDatabaseDatabaseRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	d.Set("name", databaseName)
+
+	// The database has no tags of its own, it is tagged with its instance or cluster.
+	tagsAll, err := getDBMSTags(DatabaseV1Client, dbmsID, dbmsType)
+	if err != nil {
+		return fmt.Errorf("error retrieving tags of mcs_db_database %s: %s", d.Id(), err)
+	}
+	d.Set("tags_all", tagsAll)
 	if _, ok := d.GetOk("instance_id"); ok {
 		d.Set("instance_id", dbmsID)
 	}
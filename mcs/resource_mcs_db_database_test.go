@@ -6,8 +6,11 @@ import (
 	"testing"
 
 	"github.com/gophercloud/gophercloud/openstack/db/v1/databases"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccDatabaseDatabase_basic(t *testing.T) {
@@ -142,3 +145,25 @@ resource "mcs_db_database" "basic" {
   dbms_id = "${mcs_db_instance.basic.id}"
 }
 `, osFlavorID, osDBDatastoreVersion, osDBDatastoreType, osNetworkID)
+
+func TestResourceDatabaseDatabaseReadTags(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/db/clusters/cluster/databases", `{"databases": [{"name": "db"}]}`)
+	handleGetFixture(t, "/db/clusters/cluster", `{"cluster": {"id": "cluster", "metadata": {"team": "billing"}}}`)
+
+	databaseClient := fake.ServiceClient()
+	databaseClient.Endpoint = th.Endpoint() + "db/"
+	config := &dummyConfig{}
+	config.On("GetRegion").Return("RegionOne")
+	config.On("DatabaseV1Client", "RegionOne").Return(databaseClient, nil)
+
+	d := resourceDatabaseDatabase().TestResourceData()
+	d.SetId("cluster/db")
+	d.Set("dbms_id", "cluster")
+	d.Set("dbms_type", dbmsTypeCluster)
+
+	assert.NoError(t, resourceDatabaseDatabaseRead(d, config))
+	assert.Equal(t, map[string]interface{}{"team": "billing"}, d.Get("tags_all"))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 // Dbaas timeouts
@@ -160,6 +161,15 @@ func resourceDatabaseInstance() *schema.Resource {
 				},
 			},
 
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
 			"configuration_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -196,10 +206,19 @@ func resourceDatabaseInstance() *schema.Resource {
 			},
 
 			"floating_ip_enabled": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Computed: false,
-				ForceNew: true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      false,
+				ForceNew:      true,
+				ConflictsWith: []string{"floating_ip"},
+			},
+
+			"floating_ip": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      false,
+				ValidateFunc:  validation.IsIPAddress,
+				ConflictsWith: []string{"floating_ip_enabled"},
 			},
 
 			"keypair": {
@@ -249,8 +268,22 @@ func resourceDatabaseInstance() *schema.Resource {
 					},
 				},
 			},
+
+			"ip": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 		CustomizeDiff: customdiff.All(
+			customizeDiffTagsAll,
+			customizeDiffKeypairExists,
+			customizeDiffVolumeTypesExist("volume_type", "wal_volume"),
+			customizeDiffFloatingIPAvailable("floating_ip"),
+			customizeDiffQuotaPreflight(databaseInstanceQuotaRequest),
 			customdiff.ValidateChange("size", func(old, new, meta interface{}) error {
 				if new.(int) < old.(int) {
 					return fmt.Errorf("the new volume size %d must be larger than the current volume size of %d", new.(int), old.(int))
@@ -297,6 +330,7 @@ func resourceDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}) er
 		AvailabilityZone:  d.Get("availability_zone").(string),
 		FloatingIPEnabled: d.Get("floating_ip_enabled").(bool),
 		Keypair:           d.Get("keypair").(string),
+		Metadata:          mergeTags(config.GetDefaultTags(), d.Get("tags").(map[string]interface{})),
 	}
 
 	message := "unable to determine mcs_db_instance"
@@ -322,6 +356,13 @@ func resourceDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if v, ok := d.GetOk("security_group_ids"); ok {
+		securityGroups := expandToStringSlice(v.(*schema.Set).List())
+		for i := range createOpts.Nics {
+			createOpts.Nics[i].SecurityGroups = securityGroups
+		}
+	}
+
 	if v, ok := d.GetOk("disk_autoexpand"); ok {
 		autoExpandOpts, err := extractDatabaseInstanceAutoExpand(v.([]interface{}))
 		if err != nil {
@@ -416,6 +457,21 @@ func resourceDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}) er
 	// Store the ID now
 	d.SetId(instance.ID)
 
+	if floatingIP, ok := d.GetOk("floating_ip"); ok {
+		networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("error creating networking client: %s", err)
+		}
+		var networkID string
+		if len(createOpts.Nics) > 0 {
+			networkID = createOpts.Nics[0].UUID
+		}
+		err = databaseInstanceFloatingIPAssociate(DatabaseV1Client, networkingClient, instance.ID, networkID, floatingIP.(string))
+		if err != nil {
+			return fmt.Errorf("error associating floating IP %s with mcs_db_instance %s: %s", floatingIP, instance.ID, err)
+		}
+	}
+
 	return resourceDatabaseInstanceRead(d, meta)
 }
 
@@ -437,6 +493,10 @@ func resourceDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("flavor_id", instance.Flavor)
 	d.Set("datastore", instance.DataStore)
 	d.Set("region", getRegion(d, config))
+	if instance.IP != nil {
+		d.Set("ip", *instance.IP)
+	}
+	readTags(d, config, instance.Metadata)
 	if instance.ReplicaOf != nil {
 		d.Set("replica_of", instance.ReplicaOf.ID)
 	}
@@ -449,6 +509,27 @@ func resourceDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("root_enabled", true)
 	}
 
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+	securityGroups, err := databaseInstanceSecurityGroups(networkingClient, instance)
+	if err != nil {
+		return fmt.Errorf("error retrieving security groups of mcs_db_instance %s: %s", d.Id(), err)
+	}
+	d.Set("security_group_ids", securityGroups)
+
+	if floatingIP, ok := d.GetOk("floating_ip"); ok {
+		fip, err := databaseInstanceFloatingIP(networkingClient, instance, floatingIP.(string))
+		if err != nil {
+			return fmt.Errorf("error retrieving floating IP %s of mcs_db_instance %s: %s", floatingIP, d.Id(), err)
+		}
+		if fip == nil {
+			log.Printf("[DEBUG] Floating IP %s is not associated with mcs_db_instance %s", floatingIP, d.Id())
+			d.Set("floating_ip", "")
+		}
+	}
+
 	return nil
 }
 
@@ -665,6 +746,46 @@ func resourceDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if d.HasChange("floating_ip") {
+		networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("error creating networking client: %s", err)
+		}
+		old, new := d.GetChange("floating_ip")
+		if old.(string) != "" {
+			instance, err := instanceGet(DatabaseV1Client, d.Id()).extract()
+			if err != nil {
+				return fmt.Errorf("error retrieving mcs_db_instance %s: %s", d.Id(), err)
+			}
+			// The released or reassociated floating IP is already disassociated from the instance.
+			fip, err := databaseInstanceFloatingIP(networkingClient, instance, old.(string))
+			if err == nil && fip != nil {
+				err = networkingFloatingIPAssociate(networkingClient, fip.ID, "", "")
+			}
+			if err != nil {
+				return fmt.Errorf("error disassociating floating IP %s from mcs_db_instance %s: %s", old, d.Id(), err)
+			}
+		}
+		if new.(string) != "" {
+			var networkID string
+			if nics := d.Get("network").([]interface{}); len(nics) > 0 && nics[0] != nil {
+				networkID = nics[0].(map[string]interface{})["uuid"].(string)
+			}
+			err = databaseInstanceFloatingIPAssociate(DatabaseV1Client, networkingClient, d.Id(), networkID, new.(string))
+			if err != nil {
+				return fmt.Errorf("error associating floating IP %s with mcs_db_instance %s: %s", new, d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		tagsAll := expandTags(d.Get("tags_all").(map[string]interface{}))
+		err := tagsUpdate(DatabaseV1Client, metadataURL(DatabaseV1Client, instancesAPIPath, d.Id()), "metadata", tagsAll).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("error updating tags of mcs_db_instance %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceDatabaseInstanceRead(d, meta)
 }
 
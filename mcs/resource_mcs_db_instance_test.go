@@ -1,11 +1,18 @@
 package mcs
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccDatabaseInstance_basic(t *testing.T) {
@@ -215,3 +222,124 @@ resource "mcs_db_instance" "basic" {
   root_enabled = true
 }
 `, osFlavorID, osDBDatastoreVersion, osDBDatastoreType, osNetworkID)
+
+// databaseInstanceFloatingIPFixtures serves the instance with a port and floating IPs with the
+// ports by address. Address without port is not allocated. Requests to update floating IPs
+// are recorded as "<id>=<port>".
+func databaseInstanceFloatingIPFixtures(t *testing.T, config *dummyConfig, fipPorts map[string]string) *[]string {
+	handleGetFixture(t, "/db/instances/instance", `{"instance": {"id": "instance", "name": "db", "status": "ACTIVE", "compute_instance_id": "server"}}`)
+	handleGetFixture(t, "/db/instances/instance/root", `{"rootEnabled": false}`)
+	handleGetFixture(t, "/ports", `{"ports": [{"id": "port", "device_id": "server", "network_id": "network", "security_groups": ["default", "db"]}]}`)
+
+	var updates []string
+	th.Mux.HandleFunc("/floatingips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodGet)
+		address := r.URL.Query().Get("floating_ip_address")
+		w.Header().Add("Content-Type", "application/json")
+		port, ok := fipPorts[address]
+		if !ok {
+			fmt.Fprint(w, `{"floatingips": []}`)
+			return
+		}
+		fmt.Fprintf(w, `{"floatingips": [{"id": "fip-%s", "floating_ip_address": "%s", "port_id": "%s"}]}`, address, address, port)
+	})
+	th.Mux.HandleFunc("/floatingips/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodPut)
+		var body struct {
+			FloatingIP struct {
+				PortID *string `json:"port_id"`
+			} `json:"floatingip"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		id := strings.TrimPrefix(r.URL.Path, "/floatingips/")
+		var port string
+		if body.FloatingIP.PortID != nil {
+			port = *body.FloatingIP.PortID
+		}
+		updates = append(updates, id+"="+port)
+		fipPorts[strings.TrimPrefix(id, "fip-")] = port
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"floatingip": {"id": "%s", "port_id": "%s"}}`, id, port)
+	})
+
+	databaseClient := fake.ServiceClient()
+	databaseClient.Endpoint = th.Endpoint() + "db/"
+	config.On("DatabaseV1Client", "RegionOne").Return(databaseClient, nil)
+	config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+	config.On("GetDefaultTags").Return(map[string]string{})
+	return &updates
+}
+
+func TestResourceDatabaseInstanceReadFloatingIP(t *testing.T) {
+	tests := map[string]struct {
+		fipPorts   map[string]string
+		floatingIP string
+	}{
+		"associated":    {fipPorts: map[string]string{"1.1.1.1": "port"}, floatingIP: "1.1.1.1"},
+		"disassociated": {fipPorts: map[string]string{"1.1.1.1": ""}},
+		"reassociated":  {fipPorts: map[string]string{"1.1.1.1": "other-port"}},
+		"released":      {fipPorts: map[string]string{}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			th.SetupHTTP()
+			defer th.TeardownHTTP()
+
+			config := &dummyConfig{}
+			databaseInstanceFloatingIPFixtures(t, config, tt.fipPorts)
+
+			d := resourceDatabaseInstance().TestResourceData()
+			d.SetId("instance")
+			d.Set("region", "RegionOne")
+			d.Set("floating_ip", "1.1.1.1")
+
+			assert.NoError(t, resourceDatabaseInstanceRead(d, config))
+			assert.Equal(t, tt.floatingIP, d.Get("floating_ip"))
+			assert.ElementsMatch(t, []interface{}{"default", "db"}, d.Get("security_group_ids").(*schema.Set).List())
+		})
+	}
+}
+
+func TestResourceDatabaseInstanceUpdateFloatingIP(t *testing.T) {
+	tests := map[string]struct {
+		fipPorts map[string]string
+		updates  []string
+	}{
+		"associated":   {fipPorts: map[string]string{"1.1.1.1": "port", "2.2.2.2": ""}, updates: []string{"fip-1.1.1.1=", "fip-2.2.2.2=port"}},
+		"released":     {fipPorts: map[string]string{"2.2.2.2": ""}, updates: []string{"fip-2.2.2.2=port"}},
+		"reassociated": {fipPorts: map[string]string{"1.1.1.1": "other-port", "2.2.2.2": ""}, updates: []string{"fip-2.2.2.2=port"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			th.SetupHTTP()
+			defer th.TeardownHTTP()
+
+			config := &dummyConfig{}
+			updates := databaseInstanceFloatingIPFixtures(t, config, tt.fipPorts)
+
+			d := testResourceDataUpdate(t, resourceDatabaseInstance(), "instance", map[string]string{
+				"region":              "RegionOne",
+				"name":                "db",
+				"flavor_id":           "flavor",
+				"size":                "8",
+				"datastore.#":         "1",
+				"datastore.0.type":    "mysql",
+				"datastore.0.version": "5.7",
+				"floating_ip":         "1.1.1.1",
+			}, map[string]interface{}{
+				"region":      "RegionOne",
+				"name":        "db",
+				"flavor_id":   "flavor",
+				"size":        8,
+				"datastore":   []interface{}{map[string]interface{}{"type": "mysql", "version": "5.7"}},
+				"floating_ip": "2.2.2.2",
+			})
+
+			assert.NoError(t, resourceDatabaseInstanceUpdate(d, config))
+			assert.Equal(t, tt.updates, *updates)
+			assert.Equal(t, "2.2.2.2", d.Get("floating_ip"))
+		})
+	}
+}
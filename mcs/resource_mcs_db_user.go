@@ -69,6 +69,8 @@ func resourceDatabaseUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -188,6 +190,13 @@ func resourceDatabaseUserRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("name", userName)
 
+	// The user has no tags of its own, it is tagged with its instance or cluster.
+	tagsAll, err := getDBMSTags(DatabaseV1Client, dbmsID, dbmsType)
+	if err != nil {
+		return fmt.Errorf("error retrieving tags of mcs_db_user %s: %s", d.Id(), err)
+	}
+	d.Set("tags_all", tagsAll)
+
 	databases := flattenDatabaseUserDatabases(userObj.Databases)
 	if err := d.Set("databases", databases); err != nil {
 		return fmt.Errorf("unable to set databases: %s", err)
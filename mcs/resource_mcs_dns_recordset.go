@@ -0,0 +1,225 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceDNSRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSRecordSetCreate,
+		Read:   resourceDNSRecordSetRead,
+		Update: resourceDNSRecordSetUpdate,
+		Delete: resourceDNSRecordSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(dnsCreateTimeout * time.Minute),
+			Update: schema.DefaultTimeout(dnsUpdateTimeout * time.Minute),
+			Delete: schema.DefaultTimeout(dnsDeleteTimeout * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDNSTrailingDotDiffs,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"A", "AAAA", "CNAME", "MX", "NS", "PTR", "SRV", "TXT", "CAA",
+				}, false),
+			},
+			"records": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: false,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     false,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+		},
+	}
+}
+
+// parseDNSRecordSetID splits <zone_id>/<recordset_id> ID of the recordset.
+func parseDNSRecordSetID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid format specified for mcs_dns_recordset, format must be <zone_id>/<recordset_id>")
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceDNSRecordSetCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	dnsClient, err := config.DNSV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating DNS client: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	createOpts := recordsets.CreateOpts{
+		Name:        dnsFQDN(d.Get("name").(string)),
+		Type:        d.Get("type").(string),
+		Records:     expandToStringSlice(d.Get("records").(*schema.Set).List()),
+		TTL:         d.Get("ttl").(int),
+		Description: d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] mcs_dns_recordset create options: %#v", createOpts)
+
+	rs, err := recordsets.Create(dnsClient, zoneID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_dns_recordset: %s", err)
+	}
+
+	id := fmt.Sprintf("%s/%s", zoneID, rs.ID)
+	d.SetId(id)
+
+	err = waitForDNS(dnsRecordSetStateRefreshFunc(dnsClient, zoneID, rs.ID),
+		[]string{dnsStatusPending}, []string{dnsStatusActive}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error waiting for mcs_dns_recordset %s to become ready: %s", id, err)
+	}
+
+	log.Printf("[DEBUG] Created mcs_dns_recordset %s", id)
+	return resourceDNSRecordSetRead(d, meta)
+}
+
+func resourceDNSRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	dnsClient, err := config.DNSV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating DNS client: %s", err)
+	}
+
+	zoneID, recordSetID, err := parseDNSRecordSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rs, err := recordsets.Get(dnsClient, zoneID, recordSetID).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_dns_recordset")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_dns_recordset %s: %#v", d.Id(), rs)
+
+	d.Set("region", getRegion(d, config))
+	d.Set("zone_id", rs.ZoneID)
+	d.Set("name", rs.Name)
+	d.Set("type", rs.Type)
+	d.Set("records", rs.Records)
+	d.Set("ttl", rs.TTL)
+	d.Set("description", rs.Description)
+
+	return nil
+}
+
+func resourceDNSRecordSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	dnsClient, err := config.DNSV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating DNS client: %s", err)
+	}
+
+	zoneID, recordSetID, err := parseDNSRecordSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var updateOpts recordsets.UpdateOpts
+	if d.HasChange("records") {
+		updateOpts.Records = expandToStringSlice(d.Get("records").(*schema.Set).List())
+	}
+	if d.HasChange("ttl") {
+		ttl := d.Get("ttl").(int)
+		updateOpts.TTL = &ttl
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	log.Printf("[DEBUG] mcs_dns_recordset %s update options: %#v", d.Id(), updateOpts)
+
+	if _, err := recordsets.Update(dnsClient, zoneID, recordSetID, updateOpts).Extract(); err != nil {
+		return fmt.Errorf("error updating mcs_dns_recordset %s: %s", d.Id(), err)
+	}
+
+	err = waitForDNS(dnsRecordSetStateRefreshFunc(dnsClient, zoneID, recordSetID),
+		[]string{dnsStatusPending}, []string{dnsStatusActive}, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("error waiting for mcs_dns_recordset %s to become ready: %s", d.Id(), err)
+	}
+
+	return resourceDNSRecordSetRead(d, meta)
+}
+
+func resourceDNSRecordSetDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	dnsClient, err := config.DNSV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating DNS client: %s", err)
+	}
+
+	zoneID, recordSetID, err := parseDNSRecordSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = recordsets.Delete(dnsClient, zoneID, recordSetID).ExtractErr()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error deleting mcs_dns_recordset %s: %s", d.Id(), err)
+	}
+
+	err = waitForDNS(dnsRecordSetStateRefreshFunc(dnsClient, zoneID, recordSetID),
+		[]string{dnsStatusActive, dnsStatusPending}, []string{dnsStatusDeleted}, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("error waiting for mcs_dns_recordset %s to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,62 @@
+package mcs
+
+import (
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuppressDNSTrailingDotDiffs(t *testing.T) {
+	assert.True(t, suppressDNSTrailingDotDiffs("name", "db.example.internal.", "db.example.internal", nil))
+	assert.True(t, suppressDNSTrailingDotDiffs("name", "db.example.internal.", "db.example.internal.", nil))
+	assert.False(t, suppressDNSTrailingDotDiffs("name", "db.example.internal.", "api.example.internal", nil))
+}
+
+func TestResourceDNSZoneRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/zones/zone", dnsZoneGetFixture)
+
+	config := &dummyConfig{}
+	config.On("DNSV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceDNSZone().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("zone")
+
+	assert.NoError(t, resourceDNSZoneRead(d, config))
+	assert.Equal(t, "example.internal.", d.Get("name"))
+	assert.Equal(t, "admin@example.internal", d.Get("email"))
+	assert.Equal(t, 3600, d.Get("ttl"))
+}
+
+func TestResourceDNSRecordSetRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/zones/zone/recordsets/recordset", dnsRecordSetGetFixture)
+
+	config := &dummyConfig{}
+	config.On("DNSV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceDNSRecordSet().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("zone/recordset")
+
+	assert.NoError(t, resourceDNSRecordSetRead(d, config))
+	assert.Equal(t, "zone", d.Get("zone_id"))
+	assert.Equal(t, "db.example.internal.", d.Get("name"))
+	assert.Equal(t, "A", d.Get("type"))
+	assert.ElementsMatch(t, []interface{}{"10.0.0.10", "10.0.0.11"}, d.Get("records").(*schema.Set).List())
+	assert.Equal(t, 300, d.Get("ttl"))
+
+	d.SetId("recordset")
+	assert.EqualError(t, resourceDNSRecordSetRead(d, config),
+		"invalid format specified for mcs_dns_recordset, format must be <zone_id>/<recordset_id>")
+}
@@ -0,0 +1,179 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zones"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceDNSZone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSZoneCreate,
+		Read:   resourceDNSZoneRead,
+		Update: resourceDNSZoneUpdate,
+		Delete: resourceDNSZoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(dnsCreateTimeout * time.Minute),
+			Update: schema.DefaultTimeout(dnsUpdateTimeout * time.Minute),
+			Delete: schema.DefaultTimeout(dnsDeleteTimeout * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDNSTrailingDotDiffs,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     false,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceDNSZoneCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	dnsClient, err := config.DNSV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating DNS client: %s", err)
+	}
+
+	createOpts := zones.CreateOpts{
+		Name:        dnsFQDN(d.Get("name").(string)),
+		Email:       d.Get("email").(string),
+		Description: d.Get("description").(string),
+		TTL:         d.Get("ttl").(int),
+	}
+
+	log.Printf("[DEBUG] mcs_dns_zone create options: %#v", createOpts)
+
+	z, err := zones.Create(dnsClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_dns_zone: %s", err)
+	}
+
+	d.SetId(z.ID)
+
+	err = waitForDNS(dnsZoneStateRefreshFunc(dnsClient, z.ID),
+		[]string{dnsStatusPending}, []string{dnsStatusActive}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error waiting for mcs_dns_zone %s to become ready: %s", z.ID, err)
+	}
+
+	log.Printf("[DEBUG] Created mcs_dns_zone %s", z.ID)
+	return resourceDNSZoneRead(d, meta)
+}
+
+func resourceDNSZoneRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	dnsClient, err := config.DNSV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating DNS client: %s", err)
+	}
+
+	z, err := zones.Get(dnsClient, d.Id()).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_dns_zone")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_dns_zone %s: %#v", d.Id(), z)
+
+	d.Set("region", getRegion(d, config))
+	d.Set("name", z.Name)
+	d.Set("email", z.Email)
+	d.Set("description", z.Description)
+	d.Set("ttl", z.TTL)
+
+	return nil
+}
+
+func resourceDNSZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	dnsClient, err := config.DNSV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating DNS client: %s", err)
+	}
+
+	var updateOpts zones.UpdateOpts
+	if d.HasChange("email") {
+		updateOpts.Email = d.Get("email").(string)
+	}
+	if d.HasChange("ttl") {
+		updateOpts.TTL = d.Get("ttl").(int)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	log.Printf("[DEBUG] mcs_dns_zone %s update options: %#v", d.Id(), updateOpts)
+
+	if _, err := zones.Update(dnsClient, d.Id(), updateOpts).Extract(); err != nil {
+		return fmt.Errorf("error updating mcs_dns_zone %s: %s", d.Id(), err)
+	}
+
+	err = waitForDNS(dnsZoneStateRefreshFunc(dnsClient, d.Id()),
+		[]string{dnsStatusPending}, []string{dnsStatusActive}, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("error waiting for mcs_dns_zone %s to become ready: %s", d.Id(), err)
+	}
+
+	return resourceDNSZoneRead(d, meta)
+}
+
+func resourceDNSZoneDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	dnsClient, err := config.DNSV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating DNS client: %s", err)
+	}
+
+	_, err = zones.Delete(dnsClient, d.Id()).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error deleting mcs_dns_zone %s: %s", d.Id(), err)
+	}
+
+	err = waitForDNS(dnsZoneStateRefreshFunc(dnsClient, d.Id()),
+		[]string{dnsStatusActive, dnsStatusPending}, []string{dnsStatusDeleted}, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("error waiting for mcs_dns_zone %s to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
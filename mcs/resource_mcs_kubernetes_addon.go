@@ -0,0 +1,274 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	addonCreateTimeout = 30
+	addonUpdateTimeout = 30
+	addonDeleteTimeout = 30
+	addonDelay         = 10
+)
+
+type addonStatus string
+
+var (
+	addonStatusInstalling addonStatus = "INSTALLING"
+	addonStatusUpgrading  addonStatus = "UPGRADING"
+	addonStatusInstalled  addonStatus = "INSTALLED"
+	addonStatusDeleting   addonStatus = "DELETING"
+	addonStatusDeleted    addonStatus = "DELETED"
+	addonStatusFailed     addonStatus = "FAILED"
+)
+
+func resourceKubernetesAddon() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesAddonCreate,
+		Read:   resourceKubernetesAddonRead,
+		Update: resourceKubernetesAddonUpdate,
+		Delete: resourceKubernetesAddonDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceKubernetesAddonImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(addonCreateTimeout * time.Minute),
+			Update: schema.DefaultTimeout(addonUpdateTimeout * time.Minute),
+			Delete: schema.DefaultTimeout(addonDeleteTimeout * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"values": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         false,
+				ValidateFunc:     validateYAML,
+				DiffSuppressFunc: suppressEquivalentYAMLDiffs,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKubernetesAddonCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	containerInfraClient, err := config.ContainerInfraV1Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating container infra client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	installOpts := addonInstallOpts{
+		Name:      d.Get("name").(string),
+		Version:   d.Get("version").(string),
+		Namespace: d.Get("namespace").(string),
+		Values:    d.Get("values").(string),
+	}
+
+	log.Printf("[DEBUG] mcs_kubernetes_addon install options: %#v", installOpts)
+
+	a, err := addonInstall(containerInfraClient, clusterID, &installOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error installing mcs_kubernetes_addon %s: %s", installOpts.Name, err)
+	}
+
+	// Store the addon ID.
+	d.SetId(a.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{string(addonStatusInstalling)},
+		Target:       []string{string(addonStatusInstalled)},
+		Refresh:      kubernetesAddonStateRefreshFunc(containerInfraClient, clusterID, a.ID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        addonDelay * time.Second,
+		PollInterval: createUpdatePollInterval * time.Second,
+	}
+	err = kubernetesAddonWaitForState(containerInfraClient, clusterID, stateConf)
+	if err != nil {
+		return fmt.Errorf(
+			"error waiting for mcs_kubernetes_addon %s to become installed: %s", a.ID, err)
+	}
+
+	log.Printf("[DEBUG] Installed mcs_kubernetes_addon %s", a.ID)
+	return resourceKubernetesAddonRead(d, meta)
+}
+
+func resourceKubernetesAddonRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	containerInfraClient, err := config.ContainerInfraV1Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating container infra client: %s", err)
+	}
+
+	a, err := addonGet(containerInfraClient, d.Get("cluster_id").(string), d.Id()).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_kubernetes_addon")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_kubernetes_addon %s: %#v", d.Id(), a)
+
+	d.Set("cluster_id", a.ClusterID)
+	d.Set("name", a.Name)
+	d.Set("version", a.Version)
+	d.Set("namespace", a.Namespace)
+	d.Set("values", a.Values)
+	d.Set("status", a.Status)
+	d.Set("region", getRegion(d, config))
+
+	return nil
+}
+
+func resourceKubernetesAddonUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	containerInfraClient, err := config.ContainerInfraV1Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating container infra client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	if d.HasChanges("version", "values") {
+		upgradeOpts := addonUpgradeOpts{
+			Version: d.Get("version").(string),
+			Values:  d.Get("values").(string),
+		}
+
+		_, err := addonUpgrade(containerInfraClient, clusterID, d.Id(), &upgradeOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("error upgrading mcs_kubernetes_addon %s: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{string(addonStatusUpgrading), string(addonStatusInstalling)},
+			Target:       []string{string(addonStatusInstalled)},
+			Refresh:      kubernetesAddonStateRefreshFunc(containerInfraClient, clusterID, d.Id()),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			Delay:        addonDelay * time.Second,
+			PollInterval: createUpdatePollInterval * time.Second,
+		}
+		err = kubernetesAddonWaitForState(containerInfraClient, clusterID, stateConf)
+		if err != nil {
+			return fmt.Errorf(
+				"error waiting for mcs_kubernetes_addon %s to become upgraded: %s", d.Id(), err)
+		}
+	}
+
+	return resourceKubernetesAddonRead(d, meta)
+}
+
+func resourceKubernetesAddonDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	containerInfraClient, err := config.ContainerInfraV1Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating container infra client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	if err := addonDelete(containerInfraClient, clusterID, d.Id()).ExtractErr(); err != nil {
+		return checkDeleted(d, err, "error uninstalling mcs_kubernetes_addon")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{string(addonStatusDeleting), string(addonStatusInstalled)},
+		Target:       []string{string(addonStatusDeleted)},
+		Refresh:      kubernetesAddonStateRefreshFunc(containerInfraClient, clusterID, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        addonDelay * time.Second,
+		PollInterval: deletePollInterval * time.Second,
+	}
+	err = kubernetesAddonWaitForState(containerInfraClient, clusterID, stateConf)
+	if err != nil {
+		return fmt.Errorf(
+			"error waiting for mcs_kubernetes_addon %s to become uninstalled: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// kubernetesAddonStateRefreshFunc refreshes the status of the addon itself. The cluster stays
+// RUNNING while an addon is installed or deleted and reports no failed addons, so
+// kubernetesStateRefreshFunc is only used afterwards to wait for the cluster to reconcile.
+func kubernetesAddonStateRefreshFunc(client ContainerClient, clusterID, addonID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		a, err := addonGet(client, clusterID, addonID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return &addon{ID: addonID}, string(addonStatusDeleted), nil
+			}
+			return nil, "", err
+		}
+		if a.Status == string(addonStatusFailed) {
+			return a, a.Status, fmt.Errorf("mcs_kubernetes_addon is in an error state")
+		}
+		return a, a.Status, nil
+	}
+}
+
+// kubernetesAddonWaitForState waits for the addon to reach the target status and then
+// for the cluster to finish reconciling the addon.
+func kubernetesAddonWaitForState(client ContainerClient, clusterID string, stateConf *resource.StateChangeConf) error {
+	if _, err := stateConf.WaitForState(); err != nil {
+		return err
+	}
+
+	clusterStateConf := &resource.StateChangeConf{
+		Pending:      []string{string(clusterStatusReconciling)},
+		Target:       []string{string(clusterStatusRunning)},
+		Refresh:      kubernetesStateRefreshFunc(client, clusterID),
+		Timeout:      stateConf.Timeout,
+		PollInterval: stateConf.PollInterval,
+	}
+	_, err := clusterStateConf.WaitForState()
+	return err
+}
+
+// resourceKubernetesAddonImport imports addon by "<cluster_id>/<addon_id>" identifier.
+func resourceKubernetesAddonImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format specified for mcs_kubernetes_addon, expected <cluster_id>/<addon_id>, got %s", d.Id())
+	}
+
+	d.SetId(parts[1])
+	d.Set("cluster_id", parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
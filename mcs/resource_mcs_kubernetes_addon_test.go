@@ -0,0 +1,98 @@
+package mcs
+
+import (
+	"fmt"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+const addonResourceFixture = `
+		%s
+
+		resource "mcs_kubernetes_addon" "%[2]s" {
+		  cluster_id = mcs_kubernetes_cluster.%s.id
+		  name       = "%s"
+		  version    = "%s"
+		}`
+
+func TestAccKubernetesAddon_basic(t *testing.T) {
+	var cluster cluster
+
+	clusterName := "testcluster" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+	createClusterFixture := clusterFixture(clusterName, clusterTemplateID, osFlavorID,
+		osKeypairName, osNetworkID, osSubnetworkID, "MS1", 1)
+	clusterResourceName := "mcs_kubernetes_cluster." + clusterName
+
+	addonName := "testaddon" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+	addonResourceName := "mcs_kubernetes_addon." + addonName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKubernetes(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(addonResourceFixture, testAccKubernetesClusterBasic(createClusterFixture),
+					addonName, clusterName, "cert-manager", "1.1.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKubernetesClusterExists(clusterResourceName, &cluster),
+					testAccCheckKubernetesAddonExists(addonResourceName),
+					resource.TestCheckResourceAttr(addonResourceName, "name", "cert-manager"),
+					resource.TestCheckResourceAttr(addonResourceName, "version", "1.1.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesAddonExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("addon not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*config)
+		containerInfraClient, err := config.ContainerInfraV1Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("error creating container infra client: %s", err)
+		}
+
+		found, err := addonGet(containerInfraClient, rs.Primary.Attributes["cluster_id"], rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("addon not found")
+		}
+		return nil
+	}
+}
+
+func TestKubernetesAddonStateRefreshFunc(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/clusters/cluster/addons/installed", `{"id": "installed", "status": "INSTALLED"}`)
+	handleGetFixture(t, "/clusters/cluster/addons/failed", `{"id": "failed", "status": "FAILED"}`)
+
+	client := fake.ServiceClient()
+	_, status, err := kubernetesAddonStateRefreshFunc(client, "cluster", "installed")()
+	assert.NoError(t, err)
+	assert.Equal(t, string(addonStatusInstalled), status)
+
+	_, status, err = kubernetesAddonStateRefreshFunc(client, "cluster", "failed")()
+	assert.EqualError(t, err, "mcs_kubernetes_addon is in an error state")
+	assert.Equal(t, string(addonStatusFailed), status)
+
+	a, status, err := kubernetesAddonStateRefreshFunc(client, "cluster", "deleted")()
+	assert.NoError(t, err)
+	assert.NotNil(t, a)
+	assert.Equal(t, string(addonStatusDeleted), status)
+}
@@ -3,10 +3,14 @@ package mcs
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/MailRuCloudSolutions/terraform-provider-mcs/mcs/internal/valid"
 )
@@ -55,162 +59,316 @@ func resourceKubernetesCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(operationDelete * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
-			"region": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("k8s_config", func(d *schema.ResourceDiff, meta interface{}) bool {
+				return d.Id() != "" && d.HasChange("certificate_rotation_trigger")
+			}),
+			customizeDiffTagsAll,
+			customizeDiffKeypairExists,
+			customizeDiffKubernetesClusterPrivateAccess,
+			// Pre-allocated floating IP keeps the API address across cluster replacements.
+			customizeDiffFloatingIPAvailable("api_lb_fip"),
+			customizeDiffQuotaPreflight(kubernetesClusterQuotaRequest),
+		),
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceKubernetesClusterV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceKubernetesClusterStateUpgradeV0,
 			},
-			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-					name := val.(string)
-					if err := valid.ClusterName(name); err != nil {
-						errs = append(errs, err)
-					}
-					return
-				},
-			},
-			"project_id": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Computed: true,
-			},
-			"user_id": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Computed: true,
-			},
-			"created_at": {
-				Type:     schema.TypeString,
-				ForceNew: false,
-				Computed: true,
-			},
-			"updated_at": {
-				Type:     schema.TypeString,
-				ForceNew: false,
-				Computed: true,
-			},
-			"api_address": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Computed: true,
-			},
-			"cluster_template_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: false,
-			},
-			"master_flavor": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: false,
-				Computed: true,
-			},
-			"keypair": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Optional: true,
-			},
-			"labels": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
-			},
-			"master_count": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
-			},
-			"master_addresses": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Computed: true,
-			},
-			"node_addresses": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Computed: true,
-			},
-			"stack_id": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Computed: true,
-			},
-			"network_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"subnet_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"status": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: false,
-				Computed: true,
+		},
+
+		Schema: resourceKubernetesClusterSchema(),
+	}
+}
+
+func resourceKubernetesClusterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+				name := val.(string)
+				if err := valid.ClusterName(name); err != nil {
+					errs = append(errs, err)
+				}
+				return
 			},
-			"pods_network_cidr": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+		},
+		"project_id": {
+			Type:     schema.TypeString,
+			ForceNew: true,
+			Computed: true,
+		},
+		"user_id": {
+			Type:     schema.TypeString,
+			ForceNew: true,
+			Computed: true,
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			ForceNew: false,
+			Computed: true,
+		},
+		"updated_at": {
+			Type:     schema.TypeString,
+			ForceNew: false,
+			Computed: true,
+		},
+		"api_address": {
+			Type:     schema.TypeString,
+			ForceNew: true,
+			Computed: true,
+		},
+		"cluster_template_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: false,
+		},
+		"master_flavor": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: false,
+			Computed: true,
+		},
+		"keypair": {
+			Type:     schema.TypeString,
+			ForceNew: true,
+			Optional: true,
+		},
+		"labels": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Set:      schema.HashString,
+		},
+		"ingress": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"controller": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(ingressControllers, false),
+					},
+				},
 			},
-			"floating_ip_enabled": {
-				Type:     schema.TypeBool,
-				Required: true,
-				ForceNew: true,
+		},
+		"monitoring": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Required: true,
+						ForceNew: true,
+					},
+				},
 			},
-			"api_lb_vip": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+		},
+		"network_policy": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"calico_ipip_mode": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(calicoIPIPModes, false),
+					},
+				},
 			},
-			"api_lb_fip": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+		},
+		"registry_mirrors": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 			},
-			"ingress_floating_ip": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+		},
+		"oidc": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"issuer_url": {
+						Type:     schema.TypeString,
+						Required: true,
+						ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+							issuerURL := val.(string)
+							if err := valid.OIDCIssuerURL(issuerURL); err != nil {
+								errs = append(errs, fmt.Errorf("%s: %s", err, issuerURL))
+							}
+							return
+						},
+					},
+					"client_id": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"username_claim": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"groups_claim": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"ca": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
 			},
-			"registry_auth_password": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+		},
+		"certificate_rotation_trigger": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"k8s_config": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"master_count": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			ForceNew: true,
+			Computed: true,
+		},
+		"master_addresses": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"node_addresses": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"stack_id": {
+			Type:     schema.TypeString,
+			ForceNew: true,
+			Computed: true,
+		},
+		"network_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"subnet_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: false,
+			Computed: true,
+		},
+		"pods_network_cidr": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"floating_ip_enabled": {
+			Type:     schema.TypeBool,
+			Required: true,
+			ForceNew: true,
+		},
+		"api_lb_vip": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"api_lb_fip": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+		"api_access": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"allowed_cidrs": {
+						Type:     schema.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.IsCIDR,
+						},
+					},
+				},
 			},
-			"loadbalancer_subnet_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+		},
+		"ingress_floating_ip": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"registry_auth_password": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"loadbalancer_subnet_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"tags":     tagsSchema(),
+		"tags_all": tagsAllSchema(),
+		"availability_zone": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return strings.EqualFold(old, new)
 			},
-			"availability_zone": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-					zone := val.(string)
-					if err := valid.AvailabilityZone(zone); err != nil {
-						errs = append(errs, err)
-					}
-					return
-				},
+			ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+				zone := val.(string)
+				if err := valid.AvailabilityZone(zone); err != nil {
+					errs = append(errs, err)
+				}
+				return
 			},
 		},
 	}
@@ -230,6 +388,29 @@ func resourceKubernetesClusterCreate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	// Merge labels of typed features into raw labels.
+	for key, value := range expandKubernetesClusterFeatureLabels(d) {
+		if rawValue, ok := labels[key]; ok && rawValue != value {
+			return fmt.Errorf("label %s=%s conflicts with the value %s set by the typed argument", key, rawValue, value)
+		}
+		labels[key] = value
+	}
+
+	oidc, err := extractKubernetesClusterOIDC(d.Get("oidc").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("unable to determine mcs_kubernetes_cluster oidc: %s", err)
+	}
+
+	err = checkKubernetesClusterPrivateAccess(
+		d.Get("floating_ip_enabled").(bool),
+		d.Get("api_lb_vip").(string),
+		d.Get("api_lb_fip").(string),
+		d.Get("loadbalancer_subnet_id").(string),
+	)
+	if err != nil {
+		return err
+	}
+
 	createOpts := clusterCreateOpts{
 		ClusterTemplateID:    d.Get("cluster_template_id").(string),
 		MasterFlavorID:       d.Get("master_flavor").(string),
@@ -245,6 +426,9 @@ func resourceKubernetesClusterCreate(d *schema.ResourceData, meta interface{}) e
 		LoadbalancerSubnetID: d.Get("loadbalancer_subnet_id").(string),
 		RegistryAuthPassword: d.Get("registry_auth_password").(string),
 		AvailabilityZone:     d.Get("availability_zone").(string),
+		OIDC:                 oidc,
+		APIAccess:            extractKubernetesClusterAPIAccess(d.Get("api_access").([]interface{})),
+		Tags:                 mergeTags(config.GetDefaultTags(), d.Get("tags").(map[string]interface{})),
 	}
 
 	if masterCount, ok := d.GetOk("master_count"); ok {
@@ -289,24 +473,44 @@ func resourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	cluster, err := clusterGet(containerInfraClient, d.Id()).Extract()
+	if err == nil && cluster.NewStatus == clusterStatusDeleted {
+		err = gophercloud.ErrDefault404{}
+	}
 	if err != nil {
 		return checkDeleted(d, err, "error retrieving mcs_kubernetes_cluster")
 	}
 
 	log.Printf("[DEBUG] retrieved mcs_kubernetes_cluster %s: %#v", d.Id(), cluster)
 
-	// Get and check labels map.
-	rawLabels := d.Get("labels").(map[string]interface{})
-	labels, err := extractKubernetesLabelsMap(rawLabels)
+	template, err := clusterTemplateGet(containerInfraClient, cluster.ClusterTemplateID).Extract()
 	if err != nil {
-		return err
+		return fmt.Errorf("error retrieving cluster template %s of mcs_kubernetes_cluster %s: %s", cluster.ClusterTemplateID, d.Id(), err)
 	}
 
+	labels := flattenKubernetesClusterLabels(d.Get("labels").(map[string]interface{}), cluster.Labels, template.Labels)
 	if err := d.Set("labels", labels); err != nil {
 		return fmt.Errorf("unable to set mcs_kubernetes_cluster labels: %s", err)
 	}
 
+	for key, value := range flattenKubernetesClusterFeatures(cluster.Labels) {
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("unable to set mcs_kubernetes_cluster %s: %s", key, err)
+		}
+	}
+
+	if err := d.Set("oidc", flattenKubernetesClusterOIDC(cluster.OIDC)); err != nil {
+		return fmt.Errorf("unable to set mcs_kubernetes_cluster oidc: %s", err)
+	}
+
+	if err := d.Set("api_access", flattenKubernetesClusterAPIAccess(cluster.APIAccess)); err != nil {
+		return fmt.Errorf("unable to set mcs_kubernetes_cluster api_access: %s", err)
+	}
+
+	readTags(d, config, cluster.Tags)
+
 	d.Set("name", cluster.Name)
+	d.Set("project_id", cluster.ProjectID)
+	d.Set("user_id", cluster.UserID)
 	d.Set("api_address", cluster.APIAddress)
 	d.Set("cluster_template_id", cluster.ClusterTemplateID)
 	d.Set("create_timeout", cluster.CreateTimeout)
@@ -324,10 +528,20 @@ func resourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("api_lb_fip", cluster.APILBFIP)
 	d.Set("ingress_floating_ip", cluster.IngressFloatingIP)
 	d.Set("loadbalancer_subnet_id", cluster.LoadbalancerSubnetID)
-	d.Set("registry_auth_password", cluster.RegistryAuthPassword)
-	d.Set("availability_zone", cluster.AvailabilityZone)
 	d.Set("region", getRegion(d, config))
 
+	// The password is not returned by some api versions, keep the configured one.
+	if cluster.RegistryAuthPassword != "" {
+		d.Set("registry_auth_password", cluster.RegistryAuthPassword)
+	}
+
+	k8sConfig, err := k8sConfigGet(containerInfraClient, cluster.UUID)
+	if err != nil {
+		log.Printf("[DEBUG] error getting k8s config for mcs_kubernetes_cluster %s: %s", d.Id(), err)
+	} else {
+		d.Set("k8s_config", k8sConfig)
+	}
+
 	// Allow to read old api clusters
 	if cluster.NetworkID != "" {
 		d.Set("network_id", cluster.NetworkID)
@@ -339,6 +553,11 @@ func resourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) err
 	} else {
 		d.Set("subnet_id", cluster.Labels["fixed_subnet"])
 	}
+	if cluster.AvailabilityZone != "" {
+		d.Set("availability_zone", cluster.AvailabilityZone)
+	} else {
+		d.Set("availability_zone", cluster.Labels["availability_zone"])
+	}
 
 	if err := d.Set("created_at", getTimestamp(&cluster.CreatedAt)); err != nil {
 		log.Printf("[DEBUG] Unable to set mcs_kubernetes_cluster created_at: %s", err)
@@ -386,6 +605,22 @@ func resourceKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}) e
 			if err != nil {
 				return err
 			}
+			err = checkForOIDC(d, containerInfraClient, stateConf)
+			if err != nil {
+				return err
+			}
+			err = checkForAPIAccess(d, containerInfraClient, stateConf)
+			if err != nil {
+				return err
+			}
+			err = checkForCertificateRotation(d, containerInfraClient, stateConf)
+			if err != nil {
+				return err
+			}
+			err = checkForTags(d, containerInfraClient)
+			if err != nil {
+				return err
+			}
 		} else {
 			return fmt.Errorf("changing cluster attributes is prohibited when cluster has SHUTOFF status")
 		}
@@ -398,6 +633,22 @@ func resourceKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}) e
 		if err != nil {
 			return err
 		}
+		err = checkForOIDC(d, containerInfraClient, stateConf)
+		if err != nil {
+			return err
+		}
+		err = checkForAPIAccess(d, containerInfraClient, stateConf)
+		if err != nil {
+			return err
+		}
+		err = checkForCertificateRotation(d, containerInfraClient, stateConf)
+		if err != nil {
+			return err
+		}
+		err = checkForTags(d, containerInfraClient)
+		if err != nil {
+			return err
+		}
 		_, err = checkForStatus(d, containerInfraClient, cluster)
 		if err != nil {
 			return err
@@ -453,6 +704,94 @@ func checkForMasterFlavor(d *schema.ResourceData, containerInfraClient Container
 	return nil
 }
 
+func checkForOIDC(d *schema.ResourceData, containerInfraClient ContainerClient, stateConf *resource.StateChangeConf) error {
+	if d.HasChange("oidc") {
+		oidc, err := extractKubernetesClusterOIDC(d.Get("oidc").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("unable to determine mcs_kubernetes_cluster oidc: %s", err)
+		}
+
+		updateOpts := clusterActionsBaseOpts{
+			Action: "disable_oidc",
+		}
+		if oidc != nil {
+			updateOpts = clusterActionsBaseOpts{
+				Action:  "update_oidc",
+				Payload: oidc,
+			}
+		}
+
+		_, err = clusterUpdateMasters(containerInfraClient, d.Id(), &updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("error updating cluster's oidc : %s", err)
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf(
+				"error waiting for mcs_kubernetes_cluster %s to become updated: %s", d.Id(), err)
+		}
+	}
+	return nil
+}
+
+func checkForAPIAccess(d *schema.ResourceData, containerInfraClient ContainerClient, stateConf *resource.StateChangeConf) error {
+	if d.HasChange("api_access") {
+		apiAccess := extractKubernetesClusterAPIAccess(d.Get("api_access").([]interface{}))
+		if apiAccess == nil {
+			apiAccess = &clusterAPIAccess{AllowedCIDRs: []string{}}
+		}
+
+		updateOpts := clusterActionsBaseOpts{
+			Action:  "update_api_access",
+			Payload: apiAccess,
+		}
+
+		_, err := clusterUpdateMasters(containerInfraClient, d.Id(), &updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("error updating cluster's api access : %s", err)
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf(
+				"error waiting for mcs_kubernetes_cluster %s to become updated: %s", d.Id(), err)
+		}
+	}
+	return nil
+}
+
+func checkForCertificateRotation(d *schema.ResourceData, containerInfraClient ContainerClient, stateConf *resource.StateChangeConf) error {
+	if d.HasChange("certificate_rotation_trigger") {
+		rotateOpts := clusterActionsBaseOpts{
+			Action: "rotate_certificates",
+		}
+
+		_, err := clusterUpdateMasters(containerInfraClient, d.Id(), &rotateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("error rotating cluster's certificates : %s", err)
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf(
+				"error waiting for mcs_kubernetes_cluster %s certificates to become rotated: %s", d.Id(), err)
+		}
+	}
+	return nil
+}
+
+func checkForTags(d *schema.ResourceData, containerInfraClient ContainerClient) error {
+	if d.HasChange("tags_all") {
+		tagsAll := expandTags(d.Get("tags_all").(map[string]interface{}))
+		err := tagsUpdate(containerInfraClient, tagsURL(containerInfraClient, clustersAPIPath, d.Id()), "tags", tagsAll).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("error updating cluster's tags : %s", err)
+		}
+	}
+	return nil
+}
+
 func checkForStatus(d *schema.ResourceData, containerInfraClient ContainerClient, cluster *cluster) (bool, error) {
 
 	turnOffConf := &resource.StateChangeConf{
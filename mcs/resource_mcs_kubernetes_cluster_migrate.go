@@ -0,0 +1,43 @@
+package mcs
+
+import (
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceKubernetesClusterV0 is the schema of the cluster before master_addresses and node_addresses became lists.
+func resourceKubernetesClusterV0() *schema.Resource {
+	s := resourceKubernetesClusterSchema()
+	s["master_addresses"] = &schema.Schema{
+		Type:     schema.TypeString,
+		ForceNew: true,
+		Computed: true,
+	}
+	s["node_addresses"] = &schema.Schema{
+		Type:     schema.TypeString,
+		ForceNew: true,
+		Computed: true,
+	}
+	return &schema.Resource{Schema: s}
+}
+
+// resourceKubernetesClusterStateUpgradeV0 converts string master_addresses and node_addresses to lists.
+// They are refreshed from the API on the next read, only the type of the stored value matters.
+func resourceKubernetesClusterStateUpgradeV0(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	for _, key := range []string{"master_addresses", "node_addresses"} {
+		addresses := []interface{}{}
+		if v, ok := rawState[key].(string); ok && v != "" {
+			for _, address := range strings.Split(v, ",") {
+				addresses = append(addresses, strings.TrimSpace(address))
+			}
+		}
+		if _, ok := rawState[key].([]interface{}); !ok {
+			rawState[key] = addresses
+		}
+	}
+
+	log.Printf("[DEBUG] Upgraded mcs_kubernetes_cluster state to v1: %#v", rawState)
+	return rawState, nil
+}
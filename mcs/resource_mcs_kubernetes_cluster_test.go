@@ -2,14 +2,20 @@ package mcs
 
 import (
 	"fmt"
+	"net/http"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	uuid "github.com/satori/go.uuid"
 )
@@ -85,6 +91,9 @@ func TestMockAccKubernetesCluster_basic(t *testing.T) {
 	clientFixture.On("ServiceURL", []string{"clusters"}).Return(testAccURL)
 	clientFixture.On("ServiceURL", []string{"clusters", clusterUUID}).Return(testAccURL)
 	clientFixture.On("ServiceURL", []string{"clusters", clusterUUID, "actions"}).Return(testAccURL)
+	clientFixture.On("ServiceURL", []string{"clusters", clusterUUID, "kube_config"}).Return(testAccURL)
+	// Get kubeconfig
+	clientFixture.On("Get", testAccURL+"/clusters/"+clusterUUID+"/kube_config", mock.Anything, mock.Anything).Return(makeKubeConfigResponseFixture("apiVersion: v1"), nil)
 	// Create cluster
 	clientFixture.On("Post", testAccURL+"/clusters", jsonClusterFixture, mock.Anything, getRequestOpts(202)).Return(makeClusterCreateResponseFixture(clusterUUID), nil)
 	// Check it's status
@@ -211,3 +220,219 @@ func testAccKubernetesClusterBasic(createOpts *clusterCreateOpts) string {
 		createOpts.AvailabilityZone,
 	)
 }
+
+func TestResourceKubernetesClusterRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/clusters/cluster", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{
+			"uuid": "cluster",
+			"name": "k8s-cluster",
+			"project_id": "project",
+			"user_id": "user",
+			"cluster_template_id": "template",
+			"master_flavor_id": "Standard-2-4",
+			"master_count": 3,
+			"master_addresses": ["10.0.0.2", "10.0.0.3"],
+			"node_addresses": ["10.0.0.4"],
+			"network_id": "network",
+			"subnet_id": "subnet",
+			"loadbalancer_subnet_id": "lb-subnet",
+			"availability_zone": "MS1",
+			"registry_auth_password": "changed",
+			"floating_ip_enabled": true,
+			"new_status": "RUNNING",
+			"labels": {"team": "backend", "ingress_controller": "nginx", "kube_tag": "v1.20.4", "owner": "ops"},
+			"tags": {"env": "prod", "cost_center": "42"}
+		}`)
+	})
+	th.Mux.HandleFunc("/clusters/cluster/kube_config", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, "apiVersion: v1")
+	})
+	// Labels of the template are added by the api, the owner label is added out of band.
+	handleGetFixture(t, "/clustertemplates/template", `{"uuid": "template", "labels": {"kube_tag": "v1.20.4"}}`)
+
+	config := &dummyConfig{}
+	config.On("ContainerInfraV1Client", "RegionOne").Return(fake.ServiceClient(), nil)
+	config.On("GetDefaultTags").Return(map[string]string{"env": "prod"})
+
+	d := schema.TestResourceDataRaw(t, resourceKubernetesCluster().Schema, map[string]interface{}{
+		"region":                 "RegionOne",
+		"registry_auth_password": "secret",
+		"labels":                 map[string]interface{}{"team": "frontend"},
+	})
+	d.SetId("cluster")
+
+	assert.NoError(t, resourceKubernetesClusterRead(d, config))
+	assert.Equal(t, "cluster", d.Id())
+	assert.Equal(t, "k8s-cluster", d.Get("name"))
+	assert.Equal(t, "project", d.Get("project_id"))
+	assert.Equal(t, "user", d.Get("user_id"))
+	assert.Equal(t, "Standard-2-4", d.Get("master_flavor"))
+	assert.Equal(t, 3, d.Get("master_count"))
+	assert.Equal(t, []interface{}{"10.0.0.2", "10.0.0.3"}, d.Get("master_addresses"))
+	assert.Equal(t, []interface{}{"10.0.0.4"}, d.Get("node_addresses"))
+	assert.Equal(t, "lb-subnet", d.Get("loadbalancer_subnet_id"))
+	assert.Equal(t, "MS1", d.Get("availability_zone"))
+	assert.Equal(t, "changed", d.Get("registry_auth_password"))
+	assert.Equal(t, map[string]interface{}{"team": "backend", "owner": "ops"}, d.Get("labels"))
+	assert.Equal(t, "nginx", d.Get("ingress.0.controller"))
+	assert.Equal(t, "apiVersion: v1", d.Get("k8s_config"))
+	assert.Equal(t, map[string]interface{}{"cost_center": "42"}, d.Get("tags"))
+	assert.Equal(t, map[string]interface{}{"env": "prod", "cost_center": "42"}, d.Get("tags_all"))
+}
+
+func TestResourceKubernetesClusterReadDeleted(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/clusters/deleted", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"uuid": "deleted", "new_status": "DELETED"}`)
+	})
+
+	config := &dummyConfig{}
+	config.On("ContainerInfraV1Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	for _, id := range []string{"deleted", "notfound"} {
+		d := schema.TestResourceDataRaw(t, resourceKubernetesCluster().Schema, map[string]interface{}{
+			"region": "RegionOne",
+		})
+		d.SetId(id)
+
+		assert.NoError(t, resourceKubernetesClusterRead(d, config))
+		assert.Empty(t, d.Id())
+	}
+}
+
+func TestResourceKubernetesClusterStateUpgradeV0(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected []interface{}
+	}{
+		{
+			name:     "empty string",
+			value:    "",
+			expected: []interface{}{},
+		},
+		{
+			name:     "missing",
+			expected: []interface{}{},
+		},
+		{
+			name:     "addresses",
+			value:    "10.0.0.2, 10.0.0.3",
+			expected: []interface{}{"10.0.0.2", "10.0.0.3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rawState := map[string]interface{}{"id": "cluster", "name": "k8s-cluster"}
+			if tt.value != nil {
+				rawState["master_addresses"] = tt.value
+				rawState["node_addresses"] = tt.value
+			}
+
+			actual, err := resourceKubernetesClusterStateUpgradeV0(rawState, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual["master_addresses"])
+			assert.Equal(t, tt.expected, actual["node_addresses"])
+			assert.Equal(t, "k8s-cluster", actual["name"])
+		})
+	}
+}
+
+func TestResourceKubernetesClusterCertificateRotation(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	// Certificates are rotated by the masters action and the cluster reconciles until it runs again.
+	var actions []string
+	status := clusterStatusRunning
+	th.Mux.HandleFunc("/clusters/cluster/actions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `{"action": "rotate_certificates"}`)
+		actions = append(actions, "rotate_certificates")
+		status = clusterStatusReconciling
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, `{"uuid": "cluster"}`)
+	})
+	th.Mux.HandleFunc("/clusters/cluster", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"uuid": "cluster", "new_status": "%s"}`, status)
+		if status == clusterStatusReconciling {
+			status = clusterStatusRunning
+		}
+	})
+
+	config := &dummyConfig{}
+	config.On("GetRegion").Return("RegionOne")
+	config.On("GetDefaultTags").Return(map[string]string{})
+	config.On("GetQuotaPreflight").Return("")
+
+	state := map[string]string{
+		"region":                       "RegionOne",
+		"name":                         "k8s-cluster",
+		"cluster_template_id":          "template",
+		"network_id":                   "network",
+		"subnet_id":                    "subnet",
+		"floating_ip_enabled":          "true",
+		"certificate_rotation_trigger": "1",
+		"k8s_config":                   "apiVersion: v1",
+		"labels.%":                     "0",
+		"ingress.#":                    "0",
+		"monitoring.#":                 "0",
+		"network_policy.#":             "0",
+		"registry_mirrors.#":           "0",
+		"master_addresses.#":           "0",
+		"node_addresses.#":             "0",
+		"tags_all.%":                   "0",
+	}
+	raw := map[string]interface{}{
+		"region":                       "RegionOne",
+		"name":                         "k8s-cluster",
+		"cluster_template_id":          "template",
+		"network_id":                   "network",
+		"subnet_id":                    "subnet",
+		"floating_ip_enabled":          true,
+		"certificate_rotation_trigger": "2",
+	}
+
+	diff, err := resourceKubernetesCluster().Diff(&terraform.InstanceState{ID: "cluster", Attributes: state},
+		terraform.NewResourceConfigRaw(raw), config)
+	assert.NoError(t, err)
+	assert.False(t, diff.RequiresNew())
+	assert.True(t, diff.Attributes["k8s_config"].NewComputed)
+
+	raw["certificate_rotation_trigger"] = "1"
+	unchanged, err := resourceKubernetesCluster().Diff(&terraform.InstanceState{ID: "cluster", Attributes: state},
+		terraform.NewResourceConfigRaw(raw), config)
+	assert.NoError(t, err)
+	assert.Nil(t, unchanged)
+	raw["certificate_rotation_trigger"] = "2"
+
+	d := testResourceDataUpdate(t, resourceKubernetesCluster(), "cluster", state, raw)
+	stateConf := &resource.StateChangeConf{
+		Refresh:      kubernetesStateRefreshFunc(fake.ServiceClient(), "cluster"),
+		Timeout:      time.Minute,
+		PollInterval: time.Millisecond,
+		Pending:      []string{string(clusterStatusReconciling)},
+		Target:       []string{string(clusterStatusRunning)},
+	}
+	assert.NoError(t, checkForCertificateRotation(d, fake.ServiceClient(), stateConf))
+	assert.Equal(t, []string{"rotate_certificates"}, actions)
+	assert.Equal(t, clusterStatusRunning, status)
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
@@ -29,6 +30,14 @@ func resourceKubernetesNodeGroup() *schema.Resource {
 			Delete: schema.DefaultTimeout(operationDelete * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.All(
+			customizeDiffTagsAll,
+			customizeDiffVolumeTypesExist("volume_type"),
+			customizeDiffQuotaPreflight(kubernetesNodeGroupQuotaRequest),
+			customizeDiffNodeGroupVersion,
+			customizeDiffNodeGroupHealthy,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -41,14 +50,15 @@ func resourceKubernetesNodeGroup() *schema.Resource {
 				ForceNew: true,
 			},
 			"labels": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				ForceNew: false,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"key": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateKubernetesLabelKey,
 						},
 						"value": {
 							Type:     schema.TypeString,
@@ -58,14 +68,15 @@ func resourceKubernetesNodeGroup() *schema.Resource {
 				},
 			},
 			"taints": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				ForceNew: false,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"key": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateKubernetesLabelKey,
 						},
 						"value": {
 							Type:     schema.TypeString,
@@ -74,6 +85,13 @@ func resourceKubernetesNodeGroup() *schema.Resource {
 						"effect": {
 							Type:     schema.TypeString,
 							Required: true,
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								effect := val.(string)
+								if err := valid.TaintEffect(effect); err != nil {
+									errs = append(errs, fmt.Errorf("%s: %s", err, effect))
+								}
+								return
+							},
 						},
 					},
 				},
@@ -120,12 +138,85 @@ func resourceKubernetesNodeGroup() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+				Computed: true,
+			},
+			"cluster_template_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      false,
+				Computed:      true,
+				ConflictsWith: []string{"kubernetes_version"},
+			},
+			"kubernetes_version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      false,
+				Computed:      true,
+				ConflictsWith: []string{"cluster_template_id"},
+				ValidateFunc:  validateKubernetesVersion,
+			},
 			"autoscaling_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				ForceNew: false,
 				Default:  false,
 			},
+			"auto_repair": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+				Default:  false,
+			},
+			"healthy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+				Default:  true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// Unhealthy nodes are ignored if the node group is not required to be healthy.
+					return new == "false"
+				},
+			},
+			"healthy_node_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"node_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"uuid": {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -151,6 +242,16 @@ func resourceKubernetesNodeGroup() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -170,8 +271,27 @@ func resourceKubernetesNodeGroupCreate(d *schema.ResourceData, meta interface{})
 		VolumeSize:  d.Get("volume_size").(int),
 		VolumeType:  d.Get("volume_type").(string),
 		Autoscaling: d.Get("autoscaling_enabled").(bool),
+		AutoRepair:  d.Get("auto_repair").(bool),
+		Tags:        mergeTags(config.GetDefaultTags(), d.Get("tags").(map[string]interface{})),
 	}
 
+	if imageID, ok := d.GetOk("image_id"); ok {
+		imageClient, err := config.ImageV2Client(getRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("error creating image client: %s", err)
+		}
+		if err := checkNodeGroupImage(imageClient, imageID.(string)); err != nil {
+			return err
+		}
+		createOpts.ImageID = imageID.(string)
+	}
+
+	clusterTemplateID, err := kubernetesNodeGroupClusterTemplateID(d, containerInfraClient)
+	if err != nil {
+		return err
+	}
+	createOpts.ClusterTemplateID = clusterTemplateID
+
 	if zonesRaw, ok := d.GetOk("availability_zones"); ok {
 		zones := zonesRaw.([]interface{})
 		az := make([]string, 0, len(zones))
@@ -185,6 +305,10 @@ func resourceKubernetesNodeGroupCreate(d *schema.ResourceData, meta interface{})
 		createOpts.AvailabilityZones = az
 	}
 
+	if v, ok := d.GetOk("security_group_ids"); ok {
+		createOpts.SecurityGroups = expandToStringSlice(v.(*schema.Set).List())
+	}
+
 	if ngName, ok := d.GetOk("name"); ok {
 		createOpts.Name = ngName.(string)
 	} else {
@@ -192,7 +316,7 @@ func resourceKubernetesNodeGroupCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if lab, labOk := d.GetOk("labels"); labOk {
-		rawLabels := lab.([]interface{})
+		rawLabels := lab.(*schema.Set).List()
 		labels, err := extractNodeGroupLabelsList(rawLabels)
 		if err != nil {
 			return err
@@ -201,7 +325,7 @@ func resourceKubernetesNodeGroupCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if tnt, tntOk := d.GetOk("taints"); tntOk {
-		rawTaints := tnt.([]interface{})
+		rawTaints := tnt.(*schema.Set).List()
 		taints, err := extractNodeGroupTaintsList(rawTaints)
 		if err != nil {
 			return err
@@ -256,7 +380,7 @@ func resourceKubernetesNodeGroupRead(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[DEBUG] Retrieved mcs_kubernetes_node_group %s: %#v", d.Id(), s)
 
 	// Get and check labels list.
-	rawLabels := d.Get("labels").([]interface{})
+	rawLabels := d.Get("labels").(*schema.Set).List()
 	labels, err := extractNodeGroupLabelsList(rawLabels)
 	if err != nil {
 		return err
@@ -267,7 +391,7 @@ func resourceKubernetesNodeGroupRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	// Get and check taints list.
-	rawTaints := d.Get("taints").([]interface{})
+	rawTaints := d.Get("taints").(*schema.Set).List()
 	taints, err := extractNodeGroupTaintsList(rawTaints)
 	if err != nil {
 		return err
@@ -277,15 +401,38 @@ func resourceKubernetesNodeGroupRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.Set("name", s.Name)
+
+	// Node group with unhealthy nodes is flagged with healthy, so that dead nodes
+	// are not hidden behind the RUNNING state of the node group and show up in the plan.
+	healthyNodeCount := countHealthyNodes(s.Nodes)
+	if healthyNodeCount < s.NodeCount {
+		log.Printf("[WARN] mcs_kubernetes_node_group %s has %d healthy nodes of %d", d.Id(), healthyNodeCount, s.NodeCount)
+	}
 	d.Set("node_count", s.NodeCount)
+	d.Set("healthy", healthyNodeCount >= s.NodeCount)
+	d.Set("healthy_node_count", healthyNodeCount)
+	d.Set("nodes", flattenNodes(s.Nodes))
 	d.Set("max_nodes", s.MaxNodes)
 	d.Set("min_nodes", s.MinNodes)
 	d.Set("volume_size", s.VolumeSize)
 	d.Set("volume_type", s.VolumeType)
 	d.Set("flavor_id", s.FlavorID)
+	d.Set("image_id", s.ImageID)
+	d.Set("cluster_template_id", s.ClusterTemplateID)
 	d.Set("autoscaling_enabled", s.Autoscaling)
+	d.Set("auto_repair", s.AutoRepair)
 	d.Set("cluster_id", s.ClusterID)
 	d.Set("availability_zones", s.AvailabilityZones)
+	d.Set("security_group_ids", s.SecurityGroups)
+	readTags(d, config, s.Tags)
+
+	if s.ClusterTemplateID != "" {
+		template, err := clusterTemplateGet(containerInfraClient, s.ClusterTemplateID).Extract()
+		if err != nil {
+			return fmt.Errorf("error retrieving cluster template %s: %s", s.ClusterTemplateID, err)
+		}
+		d.Set("kubernetes_version", template.Version)
+	}
 
 	if err := d.Set("created_at", getTimestamp(&s.CreatedAt)); err != nil {
 		log.Printf("[DEBUG] Unable to set mcs_kubernetes_node_group created_at: %s", err)
@@ -318,21 +465,59 @@ func resourceKubernetesNodeGroupUpdate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			return fmt.Errorf("error retrieving kubernetes_node_group : %s", err)
 		}
-		scaleOpts := nodeGroupScaleOpts{
-			Delta: d.Get("node_count").(int) - s.NodeCount,
+		if delta := d.Get("node_count").(int) - s.NodeCount; delta != 0 {
+			scaleOpts := nodeGroupScaleOpts{
+				Delta: delta,
+			}
+
+			_, err = nodeGroupScale(containerInfraClient, d.Id(), &scaleOpts).Extract()
+			if err != nil {
+				return fmt.Errorf("error scaling mcs_kubernetes_node_group : %s", err)
+			}
+
+			_, err = stateConf.WaitForState()
+			if err != nil {
+				return fmt.Errorf(
+					"error waiting for mcs_kubernetes_node_group %s to become scaled: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChanges("image_id", "cluster_template_id", "kubernetes_version") {
+		upgradeOpts := nodeGroupUpgradeOpts{
+			RollingEnabled: true,
 		}
 
-		_, err = nodeGroupScale(containerInfraClient, d.Id(), &scaleOpts).Extract()
+		if d.HasChange("image_id") {
+			imageID := d.Get("image_id").(string)
+			imageClient, err := config.ImageV2Client(getRegion(d, config))
+			if err != nil {
+				return fmt.Errorf("error creating image client: %s", err)
+			}
+			if err := checkNodeGroupImage(imageClient, imageID); err != nil {
+				return err
+			}
+			upgradeOpts.ImageID = imageID
+		}
+
+		if d.HasChanges("cluster_template_id", "kubernetes_version") {
+			clusterTemplateID, err := kubernetesNodeGroupClusterTemplateID(d, containerInfraClient)
+			if err != nil {
+				return err
+			}
+			upgradeOpts.ClusterTemplateID = clusterTemplateID
+		}
+
+		_, err = nodeGroupUpgrade(containerInfraClient, d.Id(), &upgradeOpts).Extract()
 		if err != nil {
-			return fmt.Errorf("error scaling mcs_kubernetes_node_group : %s", err)
+			return fmt.Errorf("error upgrading mcs_kubernetes_node_group: %s", err)
 		}
 
 		_, err = stateConf.WaitForState()
 		if err != nil {
 			return fmt.Errorf(
-				"error waiting for mcs_kubernetes_node_group %s to become scaled: %s", d.Id(), err)
+				"error waiting for mcs_kubernetes_node_group %s to become upgraded: %s", d.Id(), err)
 		}
-
 	}
 
 	var patchOpts nodeGroupClusterPatchOpts
@@ -361,8 +546,16 @@ func resourceKubernetesNodeGroupUpdate(d *schema.ResourceData, meta interface{})
 		})
 	}
 
+	if d.HasChange("auto_repair") {
+		patchOpts = append(patchOpts, nodeGroupPatchParams{
+			Path:  "/auto_repair",
+			Value: strconv.FormatBool(d.Get("auto_repair").(bool)),
+			Op:    "replace",
+		})
+	}
+
 	if d.HasChange("labels") {
-		rawLabels := d.Get("labels").([]interface{})
+		rawLabels := d.Get("labels").(*schema.Set).List()
 		labels, err := extractNodeGroupLabelsList(rawLabels)
 		if err != nil {
 			return err
@@ -376,7 +569,7 @@ func resourceKubernetesNodeGroupUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("taints") {
-		rawTaints := d.Get("taints").([]interface{})
+		rawTaints := d.Get("taints").(*schema.Set).List()
 		taints, err := extractNodeGroupTaintsList(rawTaints)
 		if err != nil {
 			return err
@@ -402,6 +595,30 @@ func resourceKubernetesNodeGroupUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.HasChange("tags_all") {
+		tagsAll := expandTags(d.Get("tags_all").(map[string]interface{}))
+		err := tagsUpdate(containerInfraClient, tagsURL(containerInfraClient, nodeGroupsAPIPath, d.Id()), "tags", tagsAll).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("error updating mcs_kubernetes_node_group tags : %s", err)
+		}
+	}
+
+	// Unhealthy node group is updated in place only with auto_repair, the api repairs the nodes.
+	if d.HasChange("healthy") && d.Get("healthy").(bool) {
+		healthConf := &resource.StateChangeConf{
+			Refresh:      kubernetesNodeGroupHealthRefreshFunc(containerInfraClient, d.Id()),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			PollInterval: createUpdatePollInterval * time.Second,
+			Pending:      []string{nodeGroupUnhealthy},
+			Target:       []string{nodeGroupHealthy},
+		}
+		_, err = healthConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf(
+				"error waiting for mcs_kubernetes_node_group %s nodes to become repaired: %s", d.Id(), err)
+		}
+	}
+
 	return resourceKubernetesNodeGroupRead(d, meta)
 }
 
@@ -432,3 +649,15 @@ func resourceKubernetesNodeGroupDelete(d *schema.ResourceData, meta interface{})
 
 	return nil
 }
+
+// kubernetesNodeGroupClusterTemplateID returns the cluster template of the node group set
+// either with cluster_template_id or with kubernetes_version.
+func kubernetesNodeGroupClusterTemplateID(d *schema.ResourceData, client ContainerClient) (string, error) {
+	if clusterTemplateID := d.Get("cluster_template_id").(string); clusterTemplateID != "" {
+		return clusterTemplateID, nil
+	}
+	if kubernetesVersion := d.Get("kubernetes_version").(string); kubernetesVersion != "" {
+		return nodeGroupClusterTemplateID(client, d.Get("cluster_id").(string), kubernetesVersion)
+	}
+	return "", nil
+}
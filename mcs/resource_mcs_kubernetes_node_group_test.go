@@ -2,12 +2,17 @@ package mcs
 
 import (
 	"fmt"
+	"net/http"
 	"strconv"
 	"testing"
 
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func nodeGroupFixture(name, flavorID string, count, max, min int, autoscaling bool) *nodeGroupCreateOpts {
@@ -212,3 +217,57 @@ func testAccKubernetesNodeGroupBasic(clusterName, clusterResource string, fixtur
 		fixture.Autoscaling,
 	)
 }
+
+func TestResourceKubernetesNodeGroupReadUnhealthy(t *testing.T) {
+	tests := []struct {
+		name             string
+		nodes            string
+		healthyNodeCount int
+		healthy          bool
+	}{
+		{
+			name:             "all nodes are ready",
+			nodes:            `[{"uuid": "node-1", "status": "Ready"}, {"uuid": "node-2", "status": "Ready"}, {"uuid": "node-3", "status": "Ready"}]`,
+			healthyNodeCount: 3,
+			healthy:          true,
+		},
+		{
+			name:             "some nodes are not ready",
+			nodes:            `[{"uuid": "node-1", "status": "Ready"}, {"uuid": "node-2", "status": "NotReady"}, {"uuid": "node-3", "status": "Ready"}]`,
+			healthyNodeCount: 2,
+		},
+		{
+			name:             "all nodes are gone",
+			nodes:            `[]`,
+			healthyNodeCount: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th.SetupHTTP()
+			defer th.TeardownHTTP()
+
+			th.Mux.HandleFunc("/nodegroups/ng", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Add("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintf(w, `{"uuid": "ng", "name": "ng", "cluster_id": "cluster", "node_count": 3, "nodes": %s}`, tt.nodes)
+			})
+
+			config := &dummyConfig{}
+			config.On("ContainerInfraV1Client", "RegionOne").Return(fake.ServiceClient(), nil)
+			config.On("GetRegion").Return("RegionOne")
+			config.On("GetDefaultTags").Return(map[string]string{})
+
+			d := schema.TestResourceDataRaw(t, resourceKubernetesNodeGroup().Schema, map[string]interface{}{
+				"node_count": 3,
+			})
+			d.SetId("ng")
+
+			assert.NoError(t, resourceKubernetesNodeGroupRead(d, config))
+			assert.Equal(t, 3, d.Get("node_count"))
+			assert.Equal(t, tt.healthyNodeCount, d.Get("healthy_node_count"))
+			assert.Equal(t, tt.healthy, d.Get("healthy"))
+		})
+	}
+}
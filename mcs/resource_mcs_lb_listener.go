@@ -0,0 +1,267 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceLoadBalancerListener() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLoadBalancerListenerCreate,
+		Read:   resourceLoadBalancerListenerRead,
+		Update: resourceLoadBalancerListenerUpdate,
+		Delete: resourceLoadBalancerListenerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(lbCreateTimeout * time.Minute),
+			Update: schema.DefaultTimeout(lbUpdateTimeout * time.Minute),
+			Delete: schema.DefaultTimeout(lbDeleteTimeout * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(listeners.ProtocolTCP), string(listeners.ProtocolUDP),
+					string(listeners.ProtocolHTTP), string(listeners.ProtocolHTTPS),
+					string(listeners.ProtocolTerminatedHTTPS),
+				}, false),
+			},
+			"protocol_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"default_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"connection_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     false,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"default_tls_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"allowed_cidrs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: false,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: false,
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerListenerCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := listeners.CreateOpts{
+		LoadbalancerID:         d.Get("loadbalancer_id").(string),
+		Protocol:               listeners.Protocol(d.Get("protocol").(string)),
+		ProtocolPort:           d.Get("protocol_port").(int),
+		Name:                   d.Get("name").(string),
+		Description:            d.Get("description").(string),
+		DefaultPoolID:          d.Get("default_pool_id").(string),
+		DefaultTlsContainerRef: d.Get("default_tls_container_ref").(string),
+		AllowedCIDRs:           expandToStringSlice(d.Get("allowed_cidrs").([]interface{})),
+		AdminStateUp:           &adminStateUp,
+	}
+	if v, ok := d.GetOkExists("connection_limit"); ok {
+		connLimit := v.(int)
+		createOpts.ConnLimit = &connLimit
+	}
+
+	log.Printf("[DEBUG] mcs_lb_listener create options: %#v", createOpts)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if err := lbWaitForLoadBalancerActive(lbClient, createOpts.LoadbalancerID, timeout); err != nil {
+		return err
+	}
+
+	listener, err := listeners.Create(lbClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_lb_listener: %s", err)
+	}
+
+	d.SetId(listener.ID)
+
+	if err := lbWaitForLoadBalancerActive(lbClient, createOpts.LoadbalancerID, timeout); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Created mcs_lb_listener %s", listener.ID)
+	return resourceLoadBalancerListenerRead(d, meta)
+}
+
+func resourceLoadBalancerListenerRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	listener, err := listeners.Get(lbClient, d.Id()).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_lb_listener")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_lb_listener %s: %#v", d.Id(), listener)
+
+	d.Set("region", getRegion(d, config))
+	if len(listener.Loadbalancers) > 0 {
+		d.Set("loadbalancer_id", listener.Loadbalancers[0].ID)
+	}
+	d.Set("protocol", listener.Protocol)
+	d.Set("protocol_port", listener.ProtocolPort)
+	d.Set("name", listener.Name)
+	d.Set("description", listener.Description)
+	d.Set("default_pool_id", listener.DefaultPoolID)
+	d.Set("connection_limit", listener.ConnLimit)
+	d.Set("default_tls_container_ref", listener.DefaultTlsContainerRef)
+	d.Set("allowed_cidrs", listener.AllowedCIDRs)
+	d.Set("admin_state_up", listener.AdminStateUp)
+
+	return nil
+}
+
+func resourceLoadBalancerListenerUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	var updateOpts listeners.UpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("default_pool_id") {
+		defaultPoolID := d.Get("default_pool_id").(string)
+		updateOpts.DefaultPoolID = &defaultPoolID
+	}
+	if d.HasChange("connection_limit") {
+		connLimit := d.Get("connection_limit").(int)
+		updateOpts.ConnLimit = &connLimit
+	}
+	if d.HasChange("default_tls_container_ref") {
+		defaultTLSContainerRef := d.Get("default_tls_container_ref").(string)
+		updateOpts.DefaultTlsContainerRef = &defaultTLSContainerRef
+	}
+	if d.HasChange("allowed_cidrs") {
+		allowedCIDRs := expandToStringSlice(d.Get("allowed_cidrs").([]interface{}))
+		updateOpts.AllowedCIDRs = &allowedCIDRs
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+
+	log.Printf("[DEBUG] mcs_lb_listener %s update options: %#v", d.Id(), updateOpts)
+
+	lbID := d.Get("loadbalancer_id").(string)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	_, err = listeners.Update(lbClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error updating mcs_lb_listener %s: %s", d.Id(), err)
+	}
+
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	return resourceLoadBalancerListenerRead(d, meta)
+}
+
+func resourceLoadBalancerListenerDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	lbID := d.Get("loadbalancer_id").(string)
+	timeout := d.Timeout(schema.TimeoutDelete)
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	err = listeners.Delete(lbClient, d.Id()).ExtractErr()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error deleting mcs_lb_listener %s: %s", d.Id(), err)
+	}
+
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
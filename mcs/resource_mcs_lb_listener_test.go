@@ -0,0 +1,86 @@
+package mcs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceLoadBalancerListenerRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/lbaas/listeners/listener", fmt.Sprintf(lbListenerGetFixture, "db"))
+
+	config := &dummyConfig{}
+	config.On("LoadBalancerV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceLoadBalancerListener().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("listener")
+
+	assert.NoError(t, resourceLoadBalancerListenerRead(d, config))
+	assert.Equal(t, "lb", d.Get("loadbalancer_id"))
+	assert.Equal(t, "db", d.Get("name"))
+	assert.Equal(t, "TCP", d.Get("protocol"))
+	assert.Equal(t, 5432, d.Get("protocol_port"))
+
+	d.SetId("missing")
+	assert.NoError(t, resourceLoadBalancerListenerRead(d, config))
+	assert.Empty(t, d.Id())
+}
+
+func TestResourceLoadBalancerListenerUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	// The listener is updated between two waits for the load balancer.
+	var requests []string
+	th.Mux.HandleFunc("/lbaas/loadbalancers/lb", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		requests = append(requests, "lb")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, lbLoadBalancerGetFixture, "ACTIVE")
+	})
+	name := "db"
+	th.Mux.HandleFunc("/lbaas/listeners/listener", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			requests = append(requests, "listener")
+			var body map[string]map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"name": "db-replica"}, body["listener"])
+			name = body["listener"]["name"].(string)
+		}
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, lbListenerGetFixture, name)
+	})
+
+	config := &dummyConfig{}
+	config.On("LoadBalancerV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := testResourceDataUpdate(t, resourceLoadBalancerListener(), "listener", map[string]string{
+		"region":          "RegionOne",
+		"loadbalancer_id": "lb",
+		"protocol":        "TCP",
+		"protocol_port":   "5432",
+		"name":            "db",
+		"admin_state_up":  "true",
+	}, map[string]interface{}{
+		"region":          "RegionOne",
+		"loadbalancer_id": "lb",
+		"protocol":        "TCP",
+		"protocol_port":   5432,
+		"name":            "db-replica",
+	})
+
+	assert.NoError(t, resourceLoadBalancerListenerUpdate(d, config))
+	assert.Equal(t, []string{"lb", "listener", "lb"}, requests)
+	assert.Equal(t, "db-replica", d.Get("name"))
+}
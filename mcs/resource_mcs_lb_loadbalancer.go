@@ -0,0 +1,211 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceLoadBalancer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLoadBalancerCreate,
+		Read:   resourceLoadBalancerRead,
+		Update: resourceLoadBalancerUpdate,
+		Delete: resourceLoadBalancerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(lbCreateTimeout * time.Minute),
+			Update: schema.DefaultTimeout(lbUpdateTimeout * time.Minute),
+			Delete: schema.DefaultTimeout(lbDeleteTimeout * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"vip_subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"vip_port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: false,
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := loadbalancers.CreateOpts{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		VipSubnetID:      d.Get("vip_subnet_id").(string),
+		VipAddress:       d.Get("vip_address").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+		AdminStateUp:     &adminStateUp,
+	}
+
+	log.Printf("[DEBUG] mcs_lb_loadbalancer create options: %#v", createOpts)
+
+	lb, err := loadbalancers.Create(lbClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_lb_loadbalancer: %s", err)
+	}
+
+	d.SetId(lb.ID)
+
+	if err := lbWaitForLoadBalancerActive(lbClient, lb.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Created mcs_lb_loadbalancer %s", lb.ID)
+	return resourceLoadBalancerRead(d, meta)
+}
+
+func resourceLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	lb, err := loadbalancers.Get(lbClient, d.Id()).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_lb_loadbalancer")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_lb_loadbalancer %s: %#v", d.Id(), lb)
+
+	d.Set("region", getRegion(d, config))
+	d.Set("name", lb.Name)
+	d.Set("description", lb.Description)
+	d.Set("vip_subnet_id", lb.VipSubnetID)
+	d.Set("vip_address", lb.VipAddress)
+	d.Set("vip_port_id", lb.VipPortID)
+	d.Set("availability_zone", lb.AvailabilityZone)
+	d.Set("admin_state_up", lb.AdminStateUp)
+
+	return nil
+}
+
+func resourceLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	var updateOpts loadbalancers.UpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+
+	log.Printf("[DEBUG] mcs_lb_loadbalancer %s update options: %#v", d.Id(), updateOpts)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if err := lbWaitForLoadBalancerActive(lbClient, d.Id(), timeout); err != nil {
+		return err
+	}
+
+	_, err = loadbalancers.Update(lbClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error updating mcs_lb_loadbalancer %s: %s", d.Id(), err)
+	}
+
+	if err := lbWaitForLoadBalancerActive(lbClient, d.Id(), timeout); err != nil {
+		return err
+	}
+
+	return resourceLoadBalancerRead(d, meta)
+}
+
+func resourceLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	err = loadbalancers.Delete(lbClient, d.Id(), loadbalancers.DeleteOpts{}).ExtractErr()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error deleting mcs_lb_loadbalancer %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    append(getLBPendingStatuses(), string(lbProvisioningStatusActive)),
+		Target:     []string{string(lbProvisioningStatusDeleted)},
+		Refresh:    lbLoadBalancerStateRefreshFunc(lbClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      lbDelay * time.Second,
+		MinTimeout: lbMinTimeout * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for mcs_lb_loadbalancer %s to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
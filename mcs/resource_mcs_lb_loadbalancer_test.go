@@ -0,0 +1,33 @@
+package mcs
+
+import (
+	"fmt"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceLoadBalancerRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/lbaas/loadbalancers/lb", fmt.Sprintf(lbLoadBalancerGetFixture, "ACTIVE"))
+
+	config := &dummyConfig{}
+	config.On("LoadBalancerV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceLoadBalancer().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("lb")
+
+	assert.NoError(t, resourceLoadBalancerRead(d, config))
+	assert.Equal(t, "db-lb", d.Get("name"))
+	assert.Equal(t, "subnet", d.Get("vip_subnet_id"))
+	assert.Equal(t, "192.168.0.10", d.Get("vip_address"))
+	assert.Equal(t, "vip-port", d.Get("vip_port_id"))
+	assert.Equal(t, "MS1", d.Get("availability_zone"))
+}
@@ -0,0 +1,252 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceLoadBalancerMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLoadBalancerMemberCreate,
+		Read:   resourceLoadBalancerMemberRead,
+		Update: resourceLoadBalancerMemberUpdate,
+		Delete: resourceLoadBalancerMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceLoadBalancerMemberImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(lbCreateTimeout * time.Minute),
+			Update: schema.DefaultTimeout(lbUpdateTimeout * time.Minute),
+			Delete: schema.DefaultTimeout(lbDeleteTimeout * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"protocol_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     false,
+				ValidateFunc: validation.IntBetween(0, 256),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: false,
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerMemberCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := pools.CreateMemberOpts{
+		Address:      d.Get("address").(string),
+		ProtocolPort: d.Get("protocol_port").(int),
+		SubnetID:     d.Get("subnet_id").(string),
+		Name:         d.Get("name").(string),
+		AdminStateUp: &adminStateUp,
+	}
+	if v, ok := d.GetOkExists("weight"); ok {
+		weight := v.(int)
+		createOpts.Weight = &weight
+	}
+
+	log.Printf("[DEBUG] mcs_lb_member create options: %#v", createOpts)
+
+	poolID := d.Get("pool_id").(string)
+	lbID, err := lbLoadBalancerIDByPool(lbClient, poolID)
+	if err != nil {
+		return fmt.Errorf("error retrieving load balancer of pool %s: %s", poolID, err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	member, err := pools.CreateMember(lbClient, poolID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_lb_member: %s", err)
+	}
+
+	d.SetId(member.ID)
+
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Created mcs_lb_member %s", member.ID)
+	return resourceLoadBalancerMemberRead(d, meta)
+}
+
+func resourceLoadBalancerMemberRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	member, err := pools.GetMember(lbClient, d.Get("pool_id").(string), d.Id()).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_lb_member")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_lb_member %s: %#v", d.Id(), member)
+
+	d.Set("region", getRegion(d, config))
+	d.Set("address", member.Address)
+	d.Set("protocol_port", member.ProtocolPort)
+	d.Set("subnet_id", member.SubnetID)
+	d.Set("weight", member.Weight)
+	d.Set("name", member.Name)
+	d.Set("admin_state_up", member.AdminStateUp)
+
+	return nil
+}
+
+func resourceLoadBalancerMemberUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	var updateOpts pools.UpdateMemberOpts
+	if d.HasChange("weight") {
+		weight := d.Get("weight").(int)
+		updateOpts.Weight = &weight
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+
+	log.Printf("[DEBUG] mcs_lb_member %s update options: %#v", d.Id(), updateOpts)
+
+	poolID := d.Get("pool_id").(string)
+	lbID, err := lbLoadBalancerIDByPool(lbClient, poolID)
+	if err != nil {
+		return fmt.Errorf("error retrieving load balancer of pool %s: %s", poolID, err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	_, err = pools.UpdateMember(lbClient, poolID, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error updating mcs_lb_member %s: %s", d.Id(), err)
+	}
+
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	return resourceLoadBalancerMemberRead(d, meta)
+}
+
+func resourceLoadBalancerMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	lbID, err := lbLoadBalancerIDByPool(lbClient, poolID)
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error retrieving load balancer of pool %s: %s", poolID, err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	err = pools.DeleteMember(lbClient, poolID, d.Id()).ExtractErr()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error deleting mcs_lb_member %s: %s", d.Id(), err)
+	}
+
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceLoadBalancerMemberImport imports member by <pool_id>/<member_id>.
+func resourceLoadBalancerMemberImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format specified for mcs_lb_member, format must be <pool_id>/<member_id>")
+	}
+
+	d.SetId(parts[1])
+	d.Set("pool_id", parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,39 @@
+package mcs
+
+import (
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceLoadBalancerMemberImport(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/lbaas/pools/pool/members/member", lbMemberGetFixture)
+
+	config := &dummyConfig{}
+	config.On("LoadBalancerV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceLoadBalancerMember().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("pool/member")
+
+	_, err := resourceLoadBalancerMemberImport(d, config)
+	assert.NoError(t, err)
+	assert.Equal(t, "member", d.Id())
+	assert.Equal(t, "pool", d.Get("pool_id"))
+
+	assert.NoError(t, resourceLoadBalancerMemberRead(d, config))
+	assert.Equal(t, "192.168.0.20", d.Get("address"))
+	assert.Equal(t, 5432, d.Get("protocol_port"))
+	assert.Equal(t, 10, d.Get("weight"))
+
+	d.SetId("member")
+	_, err = resourceLoadBalancerMemberImport(d, config)
+	assert.Error(t, err)
+}
@@ -0,0 +1,289 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/monitors"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceLoadBalancerMonitor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLoadBalancerMonitorCreate,
+		Read:   resourceLoadBalancerMonitorRead,
+		Update: resourceLoadBalancerMonitorUpdate,
+		Delete: resourceLoadBalancerMonitorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(lbCreateTimeout * time.Minute),
+			Update: schema.DefaultTimeout(lbUpdateTimeout * time.Minute),
+			Delete: schema.DefaultTimeout(lbDeleteTimeout * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					monitors.TypePING, monitors.TypeTCP, monitors.TypeHTTP, monitors.TypeHTTPS,
+				}, false),
+			},
+			"delay": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     false,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     false,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     false,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"max_retries_down": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     false,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"url_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"http_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"expected_codes": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: false,
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := monitors.CreateOpts{
+		PoolID:         d.Get("pool_id").(string),
+		Type:           d.Get("type").(string),
+		Delay:          d.Get("delay").(int),
+		Timeout:        d.Get("timeout").(int),
+		MaxRetries:     d.Get("max_retries").(int),
+		MaxRetriesDown: d.Get("max_retries_down").(int),
+		URLPath:        d.Get("url_path").(string),
+		HTTPMethod:     d.Get("http_method").(string),
+		ExpectedCodes:  d.Get("expected_codes").(string),
+		Name:           d.Get("name").(string),
+		AdminStateUp:   &adminStateUp,
+	}
+
+	log.Printf("[DEBUG] mcs_lb_monitor create options: %#v", createOpts)
+
+	lbID, err := lbLoadBalancerIDByPool(lbClient, createOpts.PoolID)
+	if err != nil {
+		return fmt.Errorf("error retrieving load balancer of pool %s: %s", createOpts.PoolID, err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	monitor, err := monitors.Create(lbClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_lb_monitor: %s", err)
+	}
+
+	d.SetId(monitor.ID)
+
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Created mcs_lb_monitor %s", monitor.ID)
+	return resourceLoadBalancerMonitorRead(d, meta)
+}
+
+func resourceLoadBalancerMonitorRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	monitor, err := monitors.Get(lbClient, d.Id()).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_lb_monitor")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_lb_monitor %s: %#v", d.Id(), monitor)
+
+	d.Set("region", getRegion(d, config))
+	if len(monitor.Pools) > 0 {
+		d.Set("pool_id", monitor.Pools[0].ID)
+	}
+	d.Set("type", monitor.Type)
+	d.Set("delay", monitor.Delay)
+	d.Set("timeout", monitor.Timeout)
+	d.Set("max_retries", monitor.MaxRetries)
+	d.Set("max_retries_down", monitor.MaxRetriesDown)
+	d.Set("url_path", monitor.URLPath)
+	d.Set("http_method", monitor.HTTPMethod)
+	d.Set("expected_codes", monitor.ExpectedCodes)
+	d.Set("name", monitor.Name)
+	d.Set("admin_state_up", monitor.AdminStateUp)
+
+	return nil
+}
+
+func resourceLoadBalancerMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	var updateOpts monitors.UpdateOpts
+	if d.HasChange("delay") {
+		updateOpts.Delay = d.Get("delay").(int)
+	}
+	if d.HasChange("timeout") {
+		updateOpts.Timeout = d.Get("timeout").(int)
+	}
+	if d.HasChange("max_retries") {
+		updateOpts.MaxRetries = d.Get("max_retries").(int)
+	}
+	if d.HasChange("max_retries_down") {
+		updateOpts.MaxRetriesDown = d.Get("max_retries_down").(int)
+	}
+	if d.HasChange("url_path") {
+		updateOpts.URLPath = d.Get("url_path").(string)
+	}
+	if d.HasChange("http_method") {
+		updateOpts.HTTPMethod = d.Get("http_method").(string)
+	}
+	if d.HasChange("expected_codes") {
+		updateOpts.ExpectedCodes = d.Get("expected_codes").(string)
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+
+	log.Printf("[DEBUG] mcs_lb_monitor %s update options: %#v", d.Id(), updateOpts)
+
+	poolID := d.Get("pool_id").(string)
+	lbID, err := lbLoadBalancerIDByPool(lbClient, poolID)
+	if err != nil {
+		return fmt.Errorf("error retrieving load balancer of pool %s: %s", poolID, err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	_, err = monitors.Update(lbClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error updating mcs_lb_monitor %s: %s", d.Id(), err)
+	}
+
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	return resourceLoadBalancerMonitorRead(d, meta)
+}
+
+func resourceLoadBalancerMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	lbID, err := lbLoadBalancerIDByPool(lbClient, poolID)
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error retrieving load balancer of pool %s: %s", poolID, err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	err = monitors.Delete(lbClient, d.Id()).ExtractErr()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error deleting mcs_lb_monitor %s: %s", d.Id(), err)
+	}
+
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
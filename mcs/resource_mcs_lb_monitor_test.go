@@ -0,0 +1,95 @@
+package mcs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceLoadBalancerMonitorRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/lbaas/healthmonitors/monitor", fmt.Sprintf(lbMonitorGetFixture, "db"))
+
+	config := &dummyConfig{}
+	config.On("LoadBalancerV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceLoadBalancerMonitor().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("monitor")
+
+	assert.NoError(t, resourceLoadBalancerMonitorRead(d, config))
+	assert.Equal(t, "pool", d.Get("pool_id"))
+	assert.Equal(t, "db", d.Get("name"))
+	assert.Equal(t, "TCP", d.Get("type"))
+	assert.Equal(t, 10, d.Get("delay"))
+	assert.Equal(t, 5, d.Get("timeout"))
+	assert.Equal(t, 3, d.Get("max_retries"))
+
+	d.SetId("missing")
+	assert.NoError(t, resourceLoadBalancerMonitorRead(d, config))
+	assert.Empty(t, d.Id())
+}
+
+func TestResourceLoadBalancerMonitorUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	// The load balancer of the monitor is found through its pool and listener.
+	handleGetFixture(t, "/lbaas/pools/pool", lbPoolGetFixture)
+	handleGetFixture(t, "/lbaas/listeners/listener", fmt.Sprintf(lbListenerGetFixture, "db"))
+	var requests []string
+	th.Mux.HandleFunc("/lbaas/loadbalancers/lb", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		requests = append(requests, "lb")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, lbLoadBalancerGetFixture, "ACTIVE")
+	})
+	name := "db"
+	th.Mux.HandleFunc("/lbaas/healthmonitors/monitor", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			requests = append(requests, "monitor")
+			var body map[string]map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"name": "db-check"}, body["healthmonitor"])
+			name = body["healthmonitor"]["name"].(string)
+		}
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, lbMonitorGetFixture, name)
+	})
+
+	config := &dummyConfig{}
+	config.On("LoadBalancerV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := testResourceDataUpdate(t, resourceLoadBalancerMonitor(), "monitor", map[string]string{
+		"region":           "RegionOne",
+		"pool_id":          "pool",
+		"type":             "TCP",
+		"delay":            "10",
+		"timeout":          "5",
+		"max_retries":      "3",
+		"max_retries_down": "3",
+		"name":             "db",
+		"admin_state_up":   "true",
+	}, map[string]interface{}{
+		"region":      "RegionOne",
+		"pool_id":     "pool",
+		"type":        "TCP",
+		"delay":       10,
+		"timeout":     5,
+		"max_retries": 3,
+		"name":        "db-check",
+	})
+
+	assert.NoError(t, resourceLoadBalancerMonitorUpdate(d, config))
+	assert.Equal(t, []string{"lb", "monitor", "lb"}, requests)
+	assert.Equal(t, "db-check", d.Get("name"))
+}
@@ -0,0 +1,307 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceLoadBalancerPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLoadBalancerPoolCreate,
+		Read:   resourceLoadBalancerPoolRead,
+		Update: resourceLoadBalancerPoolUpdate,
+		Delete: resourceLoadBalancerPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(lbCreateTimeout * time.Minute),
+			Update: schema.DefaultTimeout(lbUpdateTimeout * time.Minute),
+			Delete: schema.DefaultTimeout(lbDeleteTimeout * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"listener_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"listener_id", "loadbalancer_id"},
+			},
+			"loadbalancer_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"listener_id", "loadbalancer_id"},
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(pools.ProtocolTCP), string(pools.ProtocolUDP),
+					string(pools.ProtocolHTTP), string(pools.ProtocolHTTPS),
+					string(pools.ProtocolPROXY),
+				}, false),
+			},
+			"lb_method": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(pools.LBMethodRoundRobin), string(pools.LBMethodLeastConnections),
+					string(pools.LBMethodSourceIp),
+				}, false),
+			},
+			"persistence": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SOURCE_IP", "HTTP_COOKIE", "APP_COOKIE",
+							}, false),
+						},
+						"cookie_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: false,
+			},
+		},
+	}
+}
+
+func expandLoadBalancerPoolPersistence(v []interface{}) *pools.SessionPersistence {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	p := v[0].(map[string]interface{})
+	return &pools.SessionPersistence{
+		Type:       p["type"].(string),
+		CookieName: p["cookie_name"].(string),
+	}
+}
+
+func flattenLoadBalancerPoolPersistence(p pools.SessionPersistence) []map[string]interface{} {
+	if p.Type == "" {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"type":        p.Type,
+			"cookie_name": p.CookieName,
+		},
+	}
+}
+
+func resourceLoadBalancerPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := pools.CreateOpts{
+		ListenerID:     d.Get("listener_id").(string),
+		LoadbalancerID: d.Get("loadbalancer_id").(string),
+		Protocol:       pools.Protocol(d.Get("protocol").(string)),
+		LBMethod:       pools.LBMethod(d.Get("lb_method").(string)),
+		Persistence:    expandLoadBalancerPoolPersistence(d.Get("persistence").([]interface{})),
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		AdminStateUp:   &adminStateUp,
+	}
+
+	log.Printf("[DEBUG] mcs_lb_pool create options: %#v", createOpts)
+
+	lbID := createOpts.LoadbalancerID
+	if lbID == "" {
+		listener, err := listeners.Get(lbClient, createOpts.ListenerID).Extract()
+		if err != nil {
+			return fmt.Errorf("error retrieving listener %s: %s", createOpts.ListenerID, err)
+		}
+		if len(listener.Loadbalancers) == 0 {
+			return fmt.Errorf("unable to find load balancer of listener %s", createOpts.ListenerID)
+		}
+		lbID = listener.Loadbalancers[0].ID
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	pool, err := pools.Create(lbClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_lb_pool: %s", err)
+	}
+
+	d.SetId(pool.ID)
+
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Created mcs_lb_pool %s", pool.ID)
+	return resourceLoadBalancerPoolRead(d, meta)
+}
+
+func resourceLoadBalancerPoolRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	pool, err := pools.Get(lbClient, d.Id()).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_lb_pool")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_lb_pool %s: %#v", d.Id(), pool)
+
+	// Pool created for a load balancer may later become default pool of a listener,
+	// so parent of the pool is only set on import.
+	if d.Get("listener_id").(string) == "" && d.Get("loadbalancer_id").(string) == "" {
+		if len(pool.Listeners) > 0 {
+			d.Set("listener_id", pool.Listeners[0].ID)
+		} else if len(pool.Loadbalancers) > 0 {
+			d.Set("loadbalancer_id", pool.Loadbalancers[0].ID)
+		}
+	}
+
+	d.Set("region", getRegion(d, config))
+	d.Set("protocol", pool.Protocol)
+	d.Set("lb_method", pool.LBMethod)
+	d.Set("persistence", flattenLoadBalancerPoolPersistence(pool.Persistence))
+	d.Set("name", pool.Name)
+	d.Set("description", pool.Description)
+	d.Set("admin_state_up", pool.AdminStateUp)
+
+	return nil
+}
+
+func resourceLoadBalancerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	var updateOpts pools.UpdateOpts
+	if d.HasChange("lb_method") {
+		updateOpts.LBMethod = pools.LBMethod(d.Get("lb_method").(string))
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+
+	log.Printf("[DEBUG] mcs_lb_pool %s update options: %#v", d.Id(), updateOpts)
+
+	lbID, err := lbLoadBalancerIDByPool(lbClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("error retrieving load balancer of mcs_lb_pool %s: %s", d.Id(), err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	_, err = pools.Update(lbClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error updating mcs_lb_pool %s: %s", d.Id(), err)
+	}
+
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	return resourceLoadBalancerPoolRead(d, meta)
+}
+
+func resourceLoadBalancerPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	lbClient, err := config.LoadBalancerV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating load balancer client: %s", err)
+	}
+
+	lbID, err := lbLoadBalancerIDByPool(lbClient, d.Id())
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error retrieving load balancer of mcs_lb_pool %s: %s", d.Id(), err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	err = pools.Delete(lbClient, d.Id()).ExtractErr()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error deleting mcs_lb_pool %s: %s", d.Id(), err)
+	}
+
+	if err := lbWaitForLoadBalancerActive(lbClient, lbID, timeout); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
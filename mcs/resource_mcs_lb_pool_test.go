@@ -0,0 +1,31 @@
+package mcs
+
+import (
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceLoadBalancerPoolReadImported(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/lbaas/pools/pool", lbPoolGetFixture)
+
+	config := &dummyConfig{}
+	config.On("LoadBalancerV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceLoadBalancerPool().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("pool")
+
+	assert.NoError(t, resourceLoadBalancerPoolRead(d, config))
+	assert.Equal(t, "listener", d.Get("listener_id"))
+	assert.Equal(t, "", d.Get("loadbalancer_id"))
+	assert.Equal(t, "ROUND_ROBIN", d.Get("lb_method"))
+	assert.Equal(t, "SOURCE_IP", d.Get("persistence.0.type"))
+}
@@ -0,0 +1,157 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceNetworkingFloatingIP() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingFloatingIPCreate,
+		Read:   resourceNetworkingFloatingIPRead,
+		Update: resourceNetworkingFloatingIPUpdate,
+		Delete: resourceNetworkingFloatingIPDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"pool": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fixed_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingFloatingIPCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	pool := d.Get("pool").(string)
+	poolID, err := networkingNetworkIDByName(networkingClient, pool)
+	if err != nil {
+		return fmt.Errorf("error retrieving floating IP pool %s: %s", pool, err)
+	}
+
+	createOpts := floatingips.CreateOpts{
+		FloatingNetworkID: poolID,
+		FloatingIP:        d.Get("address").(string),
+		Description:       d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] mcs_networking_floatingip create options: %#v", createOpts)
+
+	fip, err := floatingips.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_networking_floatingip: %s", err)
+	}
+
+	d.SetId(fip.ID)
+
+	log.Printf("[DEBUG] Created mcs_networking_floatingip %s", fip.ID)
+	return resourceNetworkingFloatingIPRead(d, meta)
+}
+
+func resourceNetworkingFloatingIPRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	fip, err := floatingips.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_networking_floatingip")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_networking_floatingip %s: %#v", d.Id(), fip)
+
+	pool, err := networks.Get(networkingClient, fip.FloatingNetworkID).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving pool of mcs_networking_floatingip %s: %s", d.Id(), err)
+	}
+
+	d.Set("region", getRegion(d, config))
+	d.Set("pool", pool.Name)
+	d.Set("address", fip.FloatingIP)
+	d.Set("description", fip.Description)
+	d.Set("port_id", fip.PortID)
+	d.Set("fixed_ip", fip.FixedIP)
+
+	return nil
+}
+
+func resourceNetworkingFloatingIPUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts := floatingips.UpdateOpts{
+			Description: &description,
+		}
+		if err := floatingips.Update(networkingClient, d.Id(), updateOpts).Err; err != nil {
+			return fmt.Errorf("error updating mcs_networking_floatingip %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkingFloatingIPRead(d, meta)
+}
+
+func resourceNetworkingFloatingIPDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	err = floatingips.Delete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			return fmt.Errorf("error deleting mcs_networking_floatingip %s: %s", d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,121 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceNetworkingFloatingIPAssociate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingFloatingIPAssociateCreate,
+		Read:   resourceNetworkingFloatingIPAssociateRead,
+		Delete: resourceNetworkingFloatingIPAssociateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"floating_ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"port_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"fixed_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+		},
+	}
+}
+
+func resourceNetworkingFloatingIPAssociateCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	address := d.Get("floating_ip").(string)
+	fip, err := networkingFloatingIPGetByAddress(networkingClient, address)
+	if err != nil {
+		return fmt.Errorf("error retrieving floating IP %s: %s", address, err)
+	}
+
+	portID := d.Get("port_id").(string)
+	err = networkingFloatingIPAssociate(networkingClient, fip.ID, portID, d.Get("fixed_ip").(string))
+	if err != nil {
+		return fmt.Errorf("error associating floating IP %s with port %s: %s", address, portID, err)
+	}
+
+	// Association is identified by the floating IP.
+	d.SetId(fip.ID)
+
+	log.Printf("[DEBUG] Created mcs_networking_floatingip_associate %s", fip.ID)
+	return resourceNetworkingFloatingIPAssociateRead(d, meta)
+}
+
+func resourceNetworkingFloatingIPAssociateRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	fip, err := floatingips.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_networking_floatingip_associate")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_networking_floatingip_associate %s: %#v", d.Id(), fip)
+
+	// Floating IP was disassociated outside of terraform.
+	if fip.PortID == "" {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("region", getRegion(d, config))
+	d.Set("floating_ip", fip.FloatingIP)
+	d.Set("port_id", fip.PortID)
+	d.Set("fixed_ip", fip.FixedIP)
+
+	return nil
+}
+
+func resourceNetworkingFloatingIPAssociateDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	err = networkingFloatingIPAssociate(networkingClient, d.Id(), "", "")
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			return fmt.Errorf("error disassociating mcs_networking_floatingip_associate %s: %s", d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
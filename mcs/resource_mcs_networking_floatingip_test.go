@@ -0,0 +1,120 @@
+package mcs
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func floatingIPListFixtureHandler(t *testing.T, portIDs map[string]string) {
+	th.Mux.HandleFunc("/floatingips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		portID, ok := portIDs[r.URL.Query().Get("floating_ip_address")]
+		if !ok {
+			fmt.Fprint(w, `{"floatingips": []}`)
+			return
+		}
+		fmt.Fprintf(w, floatingIPListFixture, portID)
+	})
+}
+
+func TestCheckNetworkingFloatingIPAvailable(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	floatingIPListFixtureHandler(t, map[string]string{
+		"89.208.84.20": "",
+		"89.208.84.21": "port",
+	})
+
+	serviceClient := fake.ServiceClient()
+	assert.NoError(t, checkNetworkingFloatingIPAvailable(serviceClient, "89.208.84.20"))
+	assert.Error(t, checkNetworkingFloatingIPAvailable(serviceClient, "89.208.84.21"))
+	assert.Error(t, checkNetworkingFloatingIPAvailable(serviceClient, "89.208.84.22"))
+}
+
+func TestCustomizeDiffFloatingIPAvailable(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	floatingIPListFixtureHandler(t, map[string]string{
+		"89.208.84.20": "",
+		"89.208.84.21": "port",
+	})
+
+	config := &dummyConfig{}
+	config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	res := &schema.Resource{
+		Schema:        resourceDatabaseInstance().Schema,
+		CustomizeDiff: customizeDiffFloatingIPAvailable("floating_ip"),
+	}
+	diff := func(address string) error {
+		_, err := res.Diff(nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"region":      "RegionOne",
+			"floating_ip": address,
+		}), config)
+		return err
+	}
+
+	assert.NoError(t, diff("89.208.84.20"))
+	assert.EqualError(t, diff("89.208.84.21"), "floating IP 89.208.84.21 is already associated with port port")
+	assert.Error(t, diff("89.208.84.22"))
+}
+
+func TestDatabaseInstanceFloatingIPAssociate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	floatingIPListFixtureHandler(t, map[string]string{"89.208.84.20": ""})
+	th.Mux.HandleFunc("/instances/db", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"instance": {"id": "db", "compute_instance_id": "server"}}`)
+	})
+	th.Mux.HandleFunc("/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestFormValues(t, r, map[string]string{"device_id": "server", "network_id": "network"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"ports": [{"id": "port", "device_id": "server", "network_id": "network"}]}`)
+	})
+	th.Mux.HandleFunc("/floatingips/fip", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"floatingip": {"port_id": "port"}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"floatingip": {"id": "fip", "port_id": "port"}}`)
+	})
+
+	serviceClient := fake.ServiceClient()
+	assert.NoError(t, databaseInstanceFloatingIPAssociate(serviceClient, serviceClient, "db", "network", "89.208.84.20"))
+}
+
+func TestResourceNetworkingFloatingIPAssociateReadDisassociated(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/floatingips/fip", `{"floatingip": {"id": "fip", "floating_ip_address": "89.208.84.20"}}`)
+
+	config := &dummyConfig{}
+	config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceNetworkingFloatingIPAssociate().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("fip")
+
+	assert.NoError(t, resourceNetworkingFloatingIPAssociateRead(d, config))
+	assert.Empty(t, d.Id())
+}
@@ -0,0 +1,199 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/portsecurity"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceNetworkingNetwork() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingNetworkCreate,
+		Read:   resourceNetworkingNetworkRead,
+		Update: resourceNetworkingNetworkUpdate,
+		Delete: resourceNetworkingNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(networkingCreateTimeout * time.Minute),
+			Delete: schema.DefaultTimeout(networkingDeleteTimeout * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"port_security_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+		},
+	}
+}
+
+func resourceNetworkingNetworkCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	networkCreateOpts := networks.CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+	if v, ok := d.GetOkExists("admin_state_up"); ok {
+		adminStateUp := v.(bool)
+		networkCreateOpts.AdminStateUp = &adminStateUp
+	}
+
+	createOpts := portsecurity.NetworkCreateOptsExt{
+		CreateOptsBuilder: networkCreateOpts,
+	}
+	if v, ok := d.GetOkExists("port_security_enabled"); ok {
+		portSecurityEnabled := v.(bool)
+		createOpts.PortSecurityEnabled = &portSecurityEnabled
+	}
+
+	log.Printf("[DEBUG] mcs_networking_network create options: %#v", createOpts)
+
+	n, err := networks.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_networking_network: %s", err)
+	}
+
+	d.SetId(n.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"BUILD"},
+		Target:     []string{"ACTIVE", "DOWN"},
+		Refresh:    networkingNetworkStateRefreshFunc(networkingClient, n.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      networkingDelay * time.Second,
+		MinTimeout: networkingMinTimeout * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for mcs_networking_network %s to become ready: %s", n.ID, err)
+	}
+
+	log.Printf("[DEBUG] Created mcs_networking_network %s", n.ID)
+	return resourceNetworkingNetworkRead(d, meta)
+}
+
+func resourceNetworkingNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	n, err := networkingNetworkGet(networkingClient, d.Id())
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_networking_network")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_networking_network %s: %#v", d.Id(), n)
+
+	d.Set("region", getRegion(d, config))
+	d.Set("name", n.Name)
+	d.Set("description", n.Description)
+	d.Set("admin_state_up", n.AdminStateUp)
+	d.Set("port_security_enabled", n.PortSecurityEnabled)
+
+	return nil
+}
+
+func resourceNetworkingNetworkUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	var networkUpdateOpts networks.UpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		networkUpdateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		networkUpdateOpts.Description = &description
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		networkUpdateOpts.AdminStateUp = &adminStateUp
+	}
+
+	updateOpts := portsecurity.NetworkUpdateOptsExt{
+		UpdateOptsBuilder: networkUpdateOpts,
+	}
+	if d.HasChange("port_security_enabled") {
+		portSecurityEnabled := d.Get("port_security_enabled").(bool)
+		updateOpts.PortSecurityEnabled = &portSecurityEnabled
+	}
+
+	log.Printf("[DEBUG] mcs_networking_network %s update options: %#v", d.Id(), updateOpts)
+
+	_, err = networks.Update(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error updating mcs_networking_network %s: %s", d.Id(), err)
+	}
+
+	return resourceNetworkingNetworkRead(d, meta)
+}
+
+func resourceNetworkingNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"ACTIVE"},
+		Target:  []string{"DELETED"},
+		Refresh: networkingDeleteRefreshFunc(
+			func() error { return networks.Get(networkingClient, d.Id()).Err },
+			func() error { return networks.Delete(networkingClient, d.Id()).ExtractErr() },
+		),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      networkingDelay * time.Second,
+		MinTimeout: networkingMinTimeout * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error deleting mcs_networking_network %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,47 @@
+package mcs
+
+import (
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceNetworkingNetworkRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/networks/network", networkGetFixture)
+
+	config := &dummyConfig{}
+	config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceNetworkingNetwork().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("network")
+
+	assert.NoError(t, resourceNetworkingNetworkRead(d, config))
+	assert.Equal(t, "private", d.Get("name"))
+	assert.Equal(t, "network for k8s", d.Get("description"))
+	assert.Equal(t, true, d.Get("admin_state_up"))
+	assert.Equal(t, true, d.Get("port_security_enabled"))
+}
+
+func TestResourceNetworkingNetworkReadDeleted(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	config := &dummyConfig{}
+	config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceNetworkingNetwork().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("notfound")
+
+	assert.NoError(t, resourceNetworkingNetworkRead(d, config))
+	assert.Empty(t, d.Id())
+}
@@ -0,0 +1,194 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceNetworkingRouter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingRouterCreate,
+		Read:   resourceNetworkingRouterRead,
+		Update: resourceNetworkingRouterUpdate,
+		Delete: resourceNetworkingRouterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(networkingCreateTimeout * time.Minute),
+			Delete: schema.DefaultTimeout(networkingDeleteTimeout * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"external_network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"external_fixed_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNetworkingRouterCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	createOpts := routers.CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+	if v, ok := d.GetOkExists("admin_state_up"); ok {
+		adminStateUp := v.(bool)
+		createOpts.AdminStateUp = &adminStateUp
+	}
+	if v, ok := d.GetOk("external_network_id"); ok {
+		createOpts.GatewayInfo = &routers.GatewayInfo{NetworkID: v.(string)}
+	}
+
+	log.Printf("[DEBUG] mcs_networking_router create options: %#v", createOpts)
+
+	r, err := routers.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_networking_router: %s", err)
+	}
+
+	d.SetId(r.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"BUILD", "PENDING_CREATE", "PENDING_UPDATE"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    networkingRouterStateRefreshFunc(networkingClient, r.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      networkingDelay * time.Second,
+		MinTimeout: networkingMinTimeout * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for mcs_networking_router %s to become ready: %s", r.ID, err)
+	}
+
+	log.Printf("[DEBUG] Created mcs_networking_router %s", r.ID)
+	return resourceNetworkingRouterRead(d, meta)
+}
+
+func resourceNetworkingRouterRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	r, err := routers.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_networking_router")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_networking_router %s: %#v", d.Id(), r)
+
+	d.Set("region", getRegion(d, config))
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+	d.Set("admin_state_up", r.AdminStateUp)
+	d.Set("external_network_id", r.GatewayInfo.NetworkID)
+	d.Set("external_fixed_ips", flattenNetworkingRouterExternalFixedIPs(r.GatewayInfo.ExternalFixedIPs))
+
+	return nil
+}
+
+func resourceNetworkingRouterUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	var updateOpts routers.UpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+	if d.HasChange("external_network_id") {
+		// Empty gateway info clears the router gateway.
+		updateOpts.GatewayInfo = &routers.GatewayInfo{NetworkID: d.Get("external_network_id").(string)}
+	}
+
+	log.Printf("[DEBUG] mcs_networking_router %s update options: %#v", d.Id(), updateOpts)
+
+	_, err = routers.Update(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error updating mcs_networking_router %s: %s", d.Id(), err)
+	}
+
+	return resourceNetworkingRouterRead(d, meta)
+}
+
+func resourceNetworkingRouterDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"ACTIVE"},
+		Target:  []string{"DELETED"},
+		Refresh: networkingDeleteRefreshFunc(
+			func() error { return routers.Get(networkingClient, d.Id()).Err },
+			func() error { return routers.Delete(networkingClient, d.Id()).ExtractErr() },
+		),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      networkingDelay * time.Second,
+		MinTimeout: networkingMinTimeout * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error deleting mcs_networking_router %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
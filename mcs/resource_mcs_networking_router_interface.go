@@ -0,0 +1,152 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceNetworkingRouterInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingRouterInterfaceCreate,
+		Read:   resourceNetworkingRouterInterfaceRead,
+		Delete: resourceNetworkingRouterInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(networkingCreateTimeout * time.Minute),
+			Delete: schema.DefaultTimeout(networkingDeleteTimeout * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"router_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"subnet_id", "port_id"},
+			},
+			"port_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"subnet_id", "port_id"},
+			},
+		},
+	}
+}
+
+func resourceNetworkingRouterInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	routerID := d.Get("router_id").(string)
+	createOpts := routers.AddInterfaceOpts{
+		SubnetID: d.Get("subnet_id").(string),
+		PortID:   d.Get("port_id").(string),
+	}
+
+	log.Printf("[DEBUG] mcs_networking_router_interface create options: %#v", createOpts)
+
+	i, err := routers.AddInterface(networkingClient, routerID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_networking_router_interface: %s", err)
+	}
+
+	// Interface is identified by the port attached to the router.
+	d.SetId(i.PortID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"BUILD", "PENDING_CREATE", "PENDING_UPDATE"},
+		Target:     []string{"ACTIVE", "DOWN"},
+		Refresh:    networkingPortStateRefreshFunc(networkingClient, i.PortID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      networkingDelay * time.Second,
+		MinTimeout: networkingMinTimeout * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for mcs_networking_router_interface %s to become ready: %s", i.PortID, err)
+	}
+
+	log.Printf("[DEBUG] Created mcs_networking_router_interface %s", i.PortID)
+	return resourceNetworkingRouterInterfaceRead(d, meta)
+}
+
+func resourceNetworkingRouterInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	p, err := ports.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_networking_router_interface")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_networking_router_interface %s: %#v", d.Id(), p)
+
+	d.Set("region", getRegion(d, config))
+	d.Set("router_id", p.DeviceID)
+	d.Set("port_id", p.ID)
+	if len(p.FixedIPs) > 0 {
+		d.Set("subnet_id", p.FixedIPs[0].SubnetID)
+	}
+
+	return nil
+}
+
+func resourceNetworkingRouterInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	routerID := d.Get("router_id").(string)
+	removeOpts := routers.RemoveInterfaceOpts{
+		PortID: d.Id(),
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"ACTIVE"},
+		Target:  []string{"DELETED"},
+		Refresh: networkingDeleteRefreshFunc(
+			func() error { return ports.Get(networkingClient, d.Id()).Err },
+			func() error { return routers.RemoveInterface(networkingClient, routerID, removeOpts).Err },
+		),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      networkingDelay * time.Second,
+		MinTimeout: networkingMinTimeout * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error deleting mcs_networking_router_interface %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,52 @@
+package mcs
+
+import (
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceNetworkingRouterRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/routers/router", routerGetFixture)
+
+	config := &dummyConfig{}
+	config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceNetworkingRouter().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("router")
+
+	assert.NoError(t, resourceNetworkingRouterRead(d, config))
+	assert.Equal(t, "router", d.Get("name"))
+	assert.Equal(t, "router for k8s", d.Get("description"))
+	assert.Equal(t, true, d.Get("admin_state_up"))
+	assert.Equal(t, "ext-net", d.Get("external_network_id"))
+	assert.Equal(t, []interface{}{"89.208.84.10"}, d.Get("external_fixed_ips"))
+}
+
+func TestResourceNetworkingRouterInterfaceRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/ports/port", routerInterfacePortGetFixture)
+
+	config := &dummyConfig{}
+	config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceNetworkingRouterInterface().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("port")
+
+	assert.NoError(t, resourceNetworkingRouterInterfaceRead(d, config))
+	assert.Equal(t, "router", d.Get("router_id"))
+	assert.Equal(t, "subnet", d.Get("subnet_id"))
+	assert.Equal(t, "port", d.Get("port_id"))
+}
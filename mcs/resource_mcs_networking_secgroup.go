@@ -0,0 +1,160 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceNetworkingSecGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingSecGroupCreate,
+		Read:   resourceNetworkingSecGroupRead,
+		Update: resourceNetworkingSecGroupUpdate,
+		Delete: resourceNetworkingSecGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(networkingDeleteTimeout * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"delete_default_rules": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingSecGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	createOpts := groups.CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] mcs_networking_secgroup create options: %#v", createOpts)
+
+	sg, err := groups.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_networking_secgroup: %s", err)
+	}
+
+	d.SetId(sg.ID)
+
+	// Security group is created with rules allowing all egress traffic.
+	if d.Get("delete_default_rules").(bool) {
+		for _, rule := range sg.Rules {
+			if err := rules.Delete(networkingClient, rule.ID).ExtractErr(); err != nil {
+				return fmt.Errorf("error deleting default rule %s of mcs_networking_secgroup %s: %s", rule.ID, sg.ID, err)
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Created mcs_networking_secgroup %s", sg.ID)
+	return resourceNetworkingSecGroupRead(d, meta)
+}
+
+func resourceNetworkingSecGroupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	sg, err := groups.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_networking_secgroup")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_networking_secgroup %s: %#v", d.Id(), sg)
+
+	d.Set("region", getRegion(d, config))
+	d.Set("name", sg.Name)
+	d.Set("description", sg.Description)
+
+	return nil
+}
+
+func resourceNetworkingSecGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	var updateOpts groups.UpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	log.Printf("[DEBUG] mcs_networking_secgroup %s update options: %#v", d.Id(), updateOpts)
+
+	_, err = groups.Update(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error updating mcs_networking_secgroup %s: %s", d.Id(), err)
+	}
+
+	return resourceNetworkingSecGroupRead(d, meta)
+}
+
+func resourceNetworkingSecGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"ACTIVE"},
+		Target:  []string{"DELETED"},
+		Refresh: networkingDeleteRefreshFunc(
+			func() error { return groups.Get(networkingClient, d.Id()).Err },
+			func() error { return groups.Delete(networkingClient, d.Id()).ExtractErr() },
+		),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      networkingDelay * time.Second,
+		MinTimeout: networkingMinTimeout * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error deleting mcs_networking_secgroup %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,180 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/MailRuCloudSolutions/terraform-provider-mcs/mcs/internal/valid"
+)
+
+func resourceNetworkingSecGroupRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingSecGroupRuleCreate,
+		Read:   resourceNetworkingSecGroupRuleRead,
+		Delete: resourceNetworkingSecGroupRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"direction": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(rules.DirIngress), string(rules.DirEgress),
+				}, false),
+			},
+			"ethertype": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(rules.EtherType4),
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(rules.EtherType4), string(rules.EtherType6),
+				}, false),
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"port_range_min": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"port_range_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"remote_ip_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IsCIDR,
+				ConflictsWith: []string{"remote_group_id"},
+			},
+			"remote_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"remote_ip_prefix"},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingSecGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	protocol := d.Get("protocol").(string)
+	portRangeMin := d.Get("port_range_min").(int)
+	portRangeMax := d.Get("port_range_max").(int)
+	if err := valid.SecGroupRulePorts(protocol, portRangeMin, portRangeMax); err != nil {
+		return fmt.Errorf("%s: port_range_min %d, port_range_max %d, protocol %q",
+			err, portRangeMin, portRangeMax, protocol)
+	}
+
+	createOpts := rules.CreateOpts{
+		SecGroupID:     d.Get("security_group_id").(string),
+		Direction:      rules.RuleDirection(d.Get("direction").(string)),
+		EtherType:      rules.RuleEtherType(d.Get("ethertype").(string)),
+		Protocol:       rules.RuleProtocol(protocol),
+		PortRangeMin:   portRangeMin,
+		PortRangeMax:   portRangeMax,
+		RemoteIPPrefix: d.Get("remote_ip_prefix").(string),
+		RemoteGroupID:  d.Get("remote_group_id").(string),
+		Description:    d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] mcs_networking_secgroup_rule create options: %#v", createOpts)
+
+	rule, err := rules.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_networking_secgroup_rule: %s", err)
+	}
+
+	d.SetId(rule.ID)
+
+	log.Printf("[DEBUG] Created mcs_networking_secgroup_rule %s", rule.ID)
+	return resourceNetworkingSecGroupRuleRead(d, meta)
+}
+
+func resourceNetworkingSecGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	rule, err := rules.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_networking_secgroup_rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_networking_secgroup_rule %s: %#v", d.Id(), rule)
+
+	d.Set("region", getRegion(d, config))
+	d.Set("security_group_id", rule.SecGroupID)
+	d.Set("direction", rule.Direction)
+	d.Set("ethertype", rule.EtherType)
+	d.Set("protocol", rule.Protocol)
+	d.Set("port_range_min", rule.PortRangeMin)
+	d.Set("port_range_max", rule.PortRangeMax)
+	d.Set("remote_ip_prefix", rule.RemoteIPPrefix)
+	d.Set("remote_group_id", rule.RemoteGroupID)
+	d.Set("description", rule.Description)
+
+	return nil
+}
+
+func resourceNetworkingSecGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	err = rules.Delete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			return fmt.Errorf("error deleting mcs_networking_secgroup_rule %s: %s", d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,74 @@
+package mcs
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceNetworkingSecGroupCreateDeleteDefaultRules(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/security-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `{"security_group": {"name": "db", "description": "access to databases"}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, secGroupGetFixture)
+	})
+	handleGetFixture(t, "/security-groups/secgroup", secGroupGetFixture)
+
+	var deletedRules []string
+	th.Mux.HandleFunc("/security-group-rules/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		deletedRules = append(deletedRules, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	config := &dummyConfig{}
+	config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceNetworkingSecGroup().Schema, map[string]interface{}{
+		"region":               "RegionOne",
+		"name":                 "db",
+		"description":          "access to databases",
+		"delete_default_rules": true,
+	})
+
+	assert.NoError(t, resourceNetworkingSecGroupCreate(d, config))
+	assert.Equal(t, "secgroup", d.Id())
+	assert.Equal(t, "db", d.Get("name"))
+	assert.Equal(t, []string{"/security-group-rules/egress-ipv4", "/security-group-rules/egress-ipv6"}, deletedRules)
+}
+
+func TestResourceNetworkingSecGroupRuleRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/security-group-rules/rule", secGroupRuleGetFixture)
+
+	config := &dummyConfig{}
+	config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceNetworkingSecGroupRule().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("rule")
+
+	assert.NoError(t, resourceNetworkingSecGroupRuleRead(d, config))
+	assert.Equal(t, "secgroup", d.Get("security_group_id"))
+	assert.Equal(t, "ingress", d.Get("direction"))
+	assert.Equal(t, "IPv4", d.Get("ethertype"))
+	assert.Equal(t, "tcp", d.Get("protocol"))
+	assert.Equal(t, 5432, d.Get("port_range_min"))
+	assert.Equal(t, 5432, d.Get("port_range_max"))
+	assert.Equal(t, "10.0.0.0/24", d.Get("remote_ip_prefix"))
+	assert.Equal(t, "postgres", d.Get("description"))
+}
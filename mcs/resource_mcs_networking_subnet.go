@@ -0,0 +1,261 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceNetworkingSubnet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingSubnetCreate,
+		Read:   resourceNetworkingSubnetRead,
+		Update: resourceNetworkingSubnetUpdate,
+		Delete: resourceNetworkingSubnetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(networkingDeleteTimeout * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"gateway_ip": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      false,
+				ValidateFunc:  validation.IsIPAddress,
+				ConflictsWith: []string{"no_gateway"},
+			},
+			"no_gateway": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      false,
+				ConflictsWith: []string{"gateway_ip"},
+			},
+			"enable_dhcp": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: false,
+			},
+			"dns_nameservers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: false,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+			"allocation_pool": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"end": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkingSubnetCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	enableDHCP := d.Get("enable_dhcp").(bool)
+	createOpts := subnets.CreateOpts{
+		NetworkID:       d.Get("network_id").(string),
+		CIDR:            d.Get("cidr").(string),
+		Name:            d.Get("name").(string),
+		Description:     d.Get("description").(string),
+		IPVersion:       gophercloud.IPv4,
+		EnableDHCP:      &enableDHCP,
+		DNSNameservers:  expandToStringSlice(d.Get("dns_nameservers").([]interface{})),
+		AllocationPools: expandNetworkingSubnetAllocationPools(d.Get("allocation_pool").([]interface{})),
+	}
+
+	// Subnet gets the first address of CIDR as a gateway unless it is set explicitly or disabled.
+	if v, ok := d.GetOk("gateway_ip"); ok {
+		gatewayIP := v.(string)
+		createOpts.GatewayIP = &gatewayIP
+	}
+	if d.Get("no_gateway").(bool) {
+		gatewayIP := ""
+		createOpts.GatewayIP = &gatewayIP
+	}
+
+	log.Printf("[DEBUG] mcs_networking_subnet create options: %#v", createOpts)
+
+	s, err := subnets.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating mcs_networking_subnet: %s", err)
+	}
+
+	d.SetId(s.ID)
+
+	log.Printf("[DEBUG] Created mcs_networking_subnet %s", s.ID)
+	return resourceNetworkingSubnetRead(d, meta)
+}
+
+func resourceNetworkingSubnetRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	s, err := subnets.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return checkDeleted(d, err, "error retrieving mcs_networking_subnet")
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_networking_subnet %s: %#v", d.Id(), s)
+
+	d.Set("region", getRegion(d, config))
+	d.Set("network_id", s.NetworkID)
+	d.Set("cidr", s.CIDR)
+	d.Set("name", s.Name)
+	d.Set("description", s.Description)
+	d.Set("gateway_ip", s.GatewayIP)
+	d.Set("no_gateway", s.GatewayIP == "")
+	d.Set("enable_dhcp", s.EnableDHCP)
+	d.Set("dns_nameservers", s.DNSNameservers)
+	d.Set("allocation_pool", flattenNetworkingSubnetAllocationPools(s.AllocationPools))
+
+	return nil
+}
+
+func resourceNetworkingSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	var updateOpts subnets.UpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("gateway_ip") {
+		gatewayIP := d.Get("gateway_ip").(string)
+		updateOpts.GatewayIP = &gatewayIP
+	}
+	if d.HasChange("no_gateway") {
+		// Restored gateway is the one set explicitly or the default first address of CIDR.
+		gatewayIP := ""
+		if !d.Get("no_gateway").(bool) {
+			gatewayIP = d.Get("gateway_ip").(string)
+			if gatewayIP == "" {
+				gatewayIP, err = networkingSubnetDefaultGateway(d.Get("cidr").(string))
+				if err != nil {
+					return fmt.Errorf("error restoring gateway of mcs_networking_subnet %s: %s", d.Id(), err)
+				}
+			}
+		}
+		updateOpts.GatewayIP = &gatewayIP
+	}
+	if d.HasChange("enable_dhcp") {
+		enableDHCP := d.Get("enable_dhcp").(bool)
+		updateOpts.EnableDHCP = &enableDHCP
+	}
+	if d.HasChange("dns_nameservers") {
+		nameservers := expandToStringSlice(d.Get("dns_nameservers").([]interface{}))
+		updateOpts.DNSNameservers = &nameservers
+	}
+	if d.HasChange("allocation_pool") {
+		updateOpts.AllocationPools = expandNetworkingSubnetAllocationPools(d.Get("allocation_pool").([]interface{}))
+	}
+
+	log.Printf("[DEBUG] mcs_networking_subnet %s update options: %#v", d.Id(), updateOpts)
+
+	_, err = subnets.Update(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error updating mcs_networking_subnet %s: %s", d.Id(), err)
+	}
+
+	return resourceNetworkingSubnetRead(d, meta)
+}
+
+func resourceNetworkingSubnetDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	networkingClient, err := config.NetworkingV2Client(getRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"ACTIVE"},
+		Target:  []string{"DELETED"},
+		Refresh: networkingDeleteRefreshFunc(
+			func() error { return subnets.Get(networkingClient, d.Id()).Err },
+			func() error { return subnets.Delete(networkingClient, d.Id()).ExtractErr() },
+		),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      networkingDelay * time.Second,
+		MinTimeout: networkingMinTimeout * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error deleting mcs_networking_subnet %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
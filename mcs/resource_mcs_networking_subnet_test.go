@@ -0,0 +1,136 @@
+package mcs
+
+import (
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceNetworkingSubnetRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/subnets/subnet", subnetGetFixture)
+
+	config := &dummyConfig{}
+	config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceNetworkingSubnet().Schema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	d.SetId("subnet")
+
+	assert.NoError(t, resourceNetworkingSubnetRead(d, config))
+	assert.Equal(t, "network", d.Get("network_id"))
+	assert.Equal(t, "private-subnet", d.Get("name"))
+	assert.Equal(t, "192.168.199.0/24", d.Get("cidr"))
+	assert.Equal(t, "192.168.199.1", d.Get("gateway_ip"))
+	assert.Equal(t, false, d.Get("no_gateway"))
+	assert.Equal(t, true, d.Get("enable_dhcp"))
+	assert.Equal(t, []interface{}{"8.8.8.8", "8.8.4.4"}, d.Get("dns_nameservers"))
+	assert.Equal(t, "192.168.199.10", d.Get("allocation_pool.0.start"))
+	assert.Equal(t, "192.168.199.200", d.Get("allocation_pool.0.end"))
+}
+
+func TestResourceNetworkingSubnetCreateNoGateway(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, http.MethodPost)
+		th.TestJSONRequest(t, r, `{
+			"subnet": {
+				"network_id": "network",
+				"cidr": "192.168.199.0/24",
+				"ip_version": 4,
+				"enable_dhcp": true,
+				"gateway_ip": null
+			}
+		}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"subnet": {"id": "subnet", "network_id": "network", "cidr": "192.168.199.0/24", "gateway_ip": null}}`))
+	})
+	handleGetFixture(t, "/subnets/subnet", `{"subnet": {"id": "subnet", "network_id": "network", "cidr": "192.168.199.0/24", "gateway_ip": null}}`)
+
+	config := &dummyConfig{}
+	config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+	d := schema.TestResourceDataRaw(t, resourceNetworkingSubnet().Schema, map[string]interface{}{
+		"region":     "RegionOne",
+		"network_id": "network",
+		"cidr":       "192.168.199.0/24",
+		"no_gateway": true,
+	})
+
+	assert.NoError(t, resourceNetworkingSubnetCreate(d, config))
+	assert.Equal(t, "subnet", d.Id())
+	assert.Equal(t, "", d.Get("gateway_ip"))
+	assert.Equal(t, true, d.Get("no_gateway"))
+}
+
+func TestResourceNetworkingSubnetUpdateGateway(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       map[string]interface{}
+		gatewayIP string
+	}{
+		{
+			name:      "restore default gateway",
+			raw:       map[string]interface{}{"no_gateway": false},
+			gatewayIP: "192.168.199.1",
+		},
+		{
+			name:      "restore explicit gateway",
+			raw:       map[string]interface{}{"gateway_ip": "192.168.199.254"},
+			gatewayIP: "192.168.199.254",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th.SetupHTTP()
+			defer th.TeardownHTTP()
+
+			var updated bool
+			th.Mux.HandleFunc("/subnets/subnet", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Add("Content-Type", "application/json")
+				if r.Method == http.MethodPut {
+					th.TestJSONRequest(t, r, `{"subnet": {"gateway_ip": "`+tt.gatewayIP+`"}}`)
+					updated = true
+				}
+				w.WriteHeader(http.StatusOK)
+				gatewayIP := "null"
+				if updated {
+					gatewayIP = `"` + tt.gatewayIP + `"`
+				}
+				w.Write([]byte(`{"subnet": {"id": "subnet", "network_id": "network", "cidr": "192.168.199.0/24", "gateway_ip": ` + gatewayIP + `}}`))
+			})
+
+			config := &dummyConfig{}
+			config.On("NetworkingV2Client", "RegionOne").Return(fake.ServiceClient(), nil)
+
+			tt.raw["region"] = "RegionOne"
+			tt.raw["network_id"] = "network"
+			tt.raw["cidr"] = "192.168.199.0/24"
+			d := testResourceDataUpdate(t, resourceNetworkingSubnet(), "subnet", map[string]string{
+				"region":      "RegionOne",
+				"network_id":  "network",
+				"cidr":        "192.168.199.0/24",
+				"gateway_ip":  "",
+				"no_gateway":  "true",
+				"enable_dhcp": "true",
+			}, tt.raw)
+
+			assert.NoError(t, resourceNetworkingSubnetUpdate(d, config))
+			assert.True(t, updated)
+			assert.Equal(t, tt.gatewayIP, d.Get("gateway_ip"))
+			assert.Equal(t, false, d.Get("no_gateway"))
+		})
+	}
+}
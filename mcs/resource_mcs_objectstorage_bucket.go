@@ -0,0 +1,367 @@
+package mcs
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// objectStorageCannedACLs are ACLs supported for buckets and objects.
+var objectStorageCannedACLs = []string{
+	s3.BucketCannedACLPrivate,
+	s3.BucketCannedACLPublicRead,
+	s3.BucketCannedACLPublicReadWrite,
+	s3.BucketCannedACLAuthenticatedRead,
+}
+
+func resourceObjectStorageBucket() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceObjectStorageBucketCreate,
+		Read:   resourceObjectStorageBucketRead,
+		Update: resourceObjectStorageBucketUpdate,
+		Delete: resourceObjectStorageBucketDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`),
+					"must be 3 to 63 characters long, consist of lowercase letters, digits, dots and hyphens "+
+						"and start and end with a letter or a digit"),
+			},
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.BucketCannedACLPrivate,
+				ValidateFunc: validation.StringInSlice(objectStorageCannedACLs, false),
+			},
+			"versioning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"lifecycle_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"expiration_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"noncurrent_version_expiration_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"abort_incomplete_multipart_upload_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func expandObjectStorageLifecycleRules(v []interface{}) []*s3.LifecycleRule {
+	rules := make([]*s3.LifecycleRule, 0, len(v))
+	for _, raw := range v {
+		r := raw.(map[string]interface{})
+		rule := &s3.LifecycleRule{
+			ID:     aws.String(r["id"].(string)),
+			Filter: &s3.LifecycleRuleFilter{Prefix: aws.String(r["prefix"].(string))},
+			Status: aws.String(s3.ExpirationStatusDisabled),
+		}
+		if r["enabled"].(bool) {
+			rule.Status = aws.String(s3.ExpirationStatusEnabled)
+		}
+		if days := r["expiration_days"].(int); days > 0 {
+			rule.Expiration = &s3.LifecycleExpiration{Days: aws.Int64(int64(days))}
+		}
+		if days := r["noncurrent_version_expiration_days"].(int); days > 0 {
+			rule.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{NoncurrentDays: aws.Int64(int64(days))}
+		}
+		if days := r["abort_incomplete_multipart_upload_days"].(int); days > 0 {
+			rule.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{DaysAfterInitiation: aws.Int64(int64(days))}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func flattenObjectStorageLifecycleRules(rules []*s3.LifecycleRule) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		r := map[string]interface{}{
+			"id":      aws.StringValue(rule.ID),
+			"prefix":  aws.StringValue(rule.Prefix),
+			"enabled": aws.StringValue(rule.Status) == s3.ExpirationStatusEnabled,
+		}
+		if rule.Filter != nil && rule.Filter.Prefix != nil {
+			r["prefix"] = aws.StringValue(rule.Filter.Prefix)
+		}
+		if rule.Expiration != nil {
+			r["expiration_days"] = int(aws.Int64Value(rule.Expiration.Days))
+		}
+		if rule.NoncurrentVersionExpiration != nil {
+			r["noncurrent_version_expiration_days"] = int(aws.Int64Value(rule.NoncurrentVersionExpiration.NoncurrentDays))
+		}
+		if rule.AbortIncompleteMultipartUpload != nil {
+			r["abort_incomplete_multipart_upload_days"] = int(aws.Int64Value(rule.AbortIncompleteMultipartUpload.DaysAfterInitiation))
+		}
+		flattened = append(flattened, r)
+	}
+	return flattened
+}
+
+// flattenObjectStorageBucketACL returns the canned ACL matching grants of the bucket to user groups.
+func flattenObjectStorageBucketACL(grants []*s3.Grant) string {
+	permissions := make(map[string]bool)
+	for _, grant := range grants {
+		if grant.Grantee == nil || aws.StringValue(grant.Grantee.Type) != s3.TypeGroup {
+			continue
+		}
+		uri := aws.StringValue(grant.Grantee.URI)
+		permissions[uri[strings.LastIndex(uri, "/")+1:]+":"+aws.StringValue(grant.Permission)] = true
+	}
+
+	switch {
+	case permissions["AllUsers:"+s3.PermissionRead] && permissions["AllUsers:"+s3.PermissionWrite]:
+		return s3.BucketCannedACLPublicReadWrite
+	case permissions["AllUsers:"+s3.PermissionRead]:
+		return s3.BucketCannedACLPublicRead
+	case permissions["AuthenticatedUsers:"+s3.PermissionRead]:
+		return s3.BucketCannedACLAuthenticatedRead
+	}
+	return s3.BucketCannedACLPrivate
+}
+
+func objectStorageBucketPutVersioning(client s3iface.S3API, bucket string, enabled bool) error {
+	status := s3.BucketVersioningStatusSuspended
+	if enabled {
+		status = s3.BucketVersioningStatusEnabled
+	}
+	_, err := client.PutBucketVersioning(&s3.PutBucketVersioningInput{
+		Bucket:                  aws.String(bucket),
+		VersioningConfiguration: &s3.VersioningConfiguration{Status: aws.String(status)},
+	})
+	return err
+}
+
+func objectStorageBucketPutLifecycleRules(client s3iface.S3API, bucket string, rules []*s3.LifecycleRule) error {
+	if len(rules) == 0 {
+		_, err := client.DeleteBucketLifecycle(&s3.DeleteBucketLifecycleInput{Bucket: aws.String(bucket)})
+		return err
+	}
+	_, err := client.PutBucketLifecycleConfiguration(&s3.PutBucketLifecycleConfigurationInput{
+		Bucket:                 aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{Rules: rules},
+	})
+	return err
+}
+
+// objectStorageBucketEmpty deletes all objects of the bucket including their previous versions.
+func objectStorageBucketEmpty(client s3iface.S3API, bucket string) error {
+	var deleteErr error
+	err := client.ListObjectVersionsPages(&s3.ListObjectVersionsInput{Bucket: aws.String(bucket)},
+		func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+			objects := make([]*s3.ObjectIdentifier, 0, len(page.Versions)+len(page.DeleteMarkers))
+			for _, v := range page.Versions {
+				objects = append(objects, &s3.ObjectIdentifier{Key: v.Key, VersionId: v.VersionId})
+			}
+			for _, m := range page.DeleteMarkers {
+				objects = append(objects, &s3.ObjectIdentifier{Key: m.Key, VersionId: m.VersionId})
+			}
+			if len(objects) == 0 {
+				return true
+			}
+
+			_, deleteErr = client.DeleteObjects(&s3.DeleteObjectsInput{
+				Bucket: aws.String(bucket),
+				Delete: &s3.Delete{Objects: objects, Quiet: aws.Bool(true)},
+			})
+			return deleteErr == nil
+		})
+	if err != nil {
+		return err
+	}
+	return deleteErr
+}
+
+func resourceObjectStorageBucketCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	client, err := config.ObjectStorageClient()
+	if err != nil {
+		return fmt.Errorf("error creating object storage client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	createOpts := &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+		ACL:    aws.String(d.Get("acl").(string)),
+	}
+
+	log.Printf("[DEBUG] mcs_objectstorage_bucket create options: %#v", createOpts)
+
+	if _, err := client.CreateBucket(createOpts); err != nil {
+		return fmt.Errorf("error creating mcs_objectstorage_bucket: %s", err)
+	}
+
+	d.SetId(bucket)
+
+	if d.Get("versioning").(bool) {
+		if err := objectStorageBucketPutVersioning(client, bucket, true); err != nil {
+			return fmt.Errorf("error enabling versioning of mcs_objectstorage_bucket %s: %s", bucket, err)
+		}
+	}
+
+	if rules := expandObjectStorageLifecycleRules(d.Get("lifecycle_rule").([]interface{})); len(rules) > 0 {
+		if err := objectStorageBucketPutLifecycleRules(client, bucket, rules); err != nil {
+			return fmt.Errorf("error setting lifecycle rules of mcs_objectstorage_bucket %s: %s", bucket, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Created mcs_objectstorage_bucket %s", bucket)
+	return resourceObjectStorageBucketRead(d, meta)
+}
+
+func resourceObjectStorageBucketRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	client, err := config.ObjectStorageClient()
+	if err != nil {
+		return fmt.Errorf("error creating object storage client: %s", err)
+	}
+
+	bucket := aws.String(d.Id())
+	if _, err := client.HeadBucket(&s3.HeadBucketInput{Bucket: bucket}); err != nil {
+		if isObjectStorageNotFound(err) {
+			log.Printf("[DEBUG] mcs_objectstorage_bucket %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error retrieving mcs_objectstorage_bucket %s: %s", d.Id(), err)
+	}
+
+	versioning, err := client.GetBucketVersioning(&s3.GetBucketVersioningInput{Bucket: bucket})
+	if err != nil {
+		return fmt.Errorf("error retrieving versioning of mcs_objectstorage_bucket %s: %s", d.Id(), err)
+	}
+
+	acl, err := client.GetBucketAcl(&s3.GetBucketAclInput{Bucket: bucket})
+	if err != nil {
+		return fmt.Errorf("error retrieving acl of mcs_objectstorage_bucket %s: %s", d.Id(), err)
+	}
+
+	var rules []*s3.LifecycleRule
+	lifecycle, err := client.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{Bucket: bucket})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != "NoSuchLifecycleConfiguration" {
+			return fmt.Errorf("error retrieving lifecycle rules of mcs_objectstorage_bucket %s: %s", d.Id(), err)
+		}
+	} else {
+		rules = lifecycle.Rules
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_objectstorage_bucket %s: %#v, %#v", d.Id(), versioning, rules)
+
+	d.Set("bucket", d.Id())
+	d.Set("acl", flattenObjectStorageBucketACL(acl.Grants))
+	d.Set("versioning", aws.StringValue(versioning.Status) == s3.BucketVersioningStatusEnabled)
+	if err := d.Set("lifecycle_rule", flattenObjectStorageLifecycleRules(rules)); err != nil {
+		return fmt.Errorf("error setting lifecycle_rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceObjectStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	client, err := config.ObjectStorageClient()
+	if err != nil {
+		return fmt.Errorf("error creating object storage client: %s", err)
+	}
+
+	if d.HasChange("acl") {
+		_, err := client.PutBucketAcl(&s3.PutBucketAclInput{
+			Bucket: aws.String(d.Id()),
+			ACL:    aws.String(d.Get("acl").(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating acl of mcs_objectstorage_bucket %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("versioning") {
+		if err := objectStorageBucketPutVersioning(client, d.Id(), d.Get("versioning").(bool)); err != nil {
+			return fmt.Errorf("error updating versioning of mcs_objectstorage_bucket %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("lifecycle_rule") {
+		rules := expandObjectStorageLifecycleRules(d.Get("lifecycle_rule").([]interface{}))
+		if err := objectStorageBucketPutLifecycleRules(client, d.Id(), rules); err != nil {
+			return fmt.Errorf("error updating lifecycle rules of mcs_objectstorage_bucket %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceObjectStorageBucketRead(d, meta)
+}
+
+func resourceObjectStorageBucketDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	client, err := config.ObjectStorageClient()
+	if err != nil {
+		return fmt.Errorf("error creating object storage client: %s", err)
+	}
+
+	if d.Get("force_destroy").(bool) {
+		if err := objectStorageBucketEmpty(client, d.Id()); err != nil && !isObjectStorageNotFound(err) {
+			return fmt.Errorf("error emptying mcs_objectstorage_bucket %s: %s", d.Id(), err)
+		}
+	}
+
+	if _, err := client.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String(d.Id())}); err != nil {
+		if isObjectStorageNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error deleting mcs_objectstorage_bucket %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,184 @@
+package mcs
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceObjectStorageObject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceObjectStorageObjectPut,
+		Read:   resourceObjectStorageObjectRead,
+		Update: resourceObjectStorageObjectPut,
+		Delete: resourceObjectStorageObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"source": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"content"},
+			},
+			"content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source"},
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.ObjectCannedACLPrivate,
+				ValidateFunc: validation.StringInSlice(objectStorageCannedACLs, false),
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceObjectStorageObjectPut uploads the object, the whole object is replaced on every update.
+func resourceObjectStorageObjectPut(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	client, err := config.ObjectStorageClient()
+	if err != nil {
+		return fmt.Errorf("error creating object storage client: %s", err)
+	}
+
+	var body io.ReadSeeker = strings.NewReader(d.Get("content").(string))
+	if source, ok := d.GetOk("source"); ok {
+		f, err := os.Open(source.(string))
+		if err != nil {
+			return fmt.Errorf("error opening source of mcs_objectstorage_object: %s", err)
+		}
+		defer f.Close()
+		body = f
+	}
+
+	bucket, key := d.Get("bucket").(string), d.Get("key").(string)
+	putOpts := &s3.PutObjectInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		Body:     body,
+		ACL:      aws.String(d.Get("acl").(string)),
+		Metadata: aws.StringMap(expandToMapStringString(d.Get("metadata").(map[string]interface{}))),
+	}
+	if contentType, ok := d.GetOk("content_type"); ok {
+		putOpts.ContentType = aws.String(contentType.(string))
+	}
+
+	log.Printf("[DEBUG] mcs_objectstorage_object put options: %#v", putOpts)
+
+	if _, err := client.PutObject(putOpts); err != nil {
+		return fmt.Errorf("error putting mcs_objectstorage_object %s/%s: %s", bucket, key, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", bucket, key))
+
+	log.Printf("[DEBUG] Put mcs_objectstorage_object %s", d.Id())
+	return resourceObjectStorageObjectRead(d, meta)
+}
+
+func resourceObjectStorageObjectRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	client, err := config.ObjectStorageClient()
+	if err != nil {
+		return fmt.Errorf("error creating object storage client: %s", err)
+	}
+
+	bucket, key, err := parseObjectStorageObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	object, err := client.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isObjectStorageNotFound(err) {
+			log.Printf("[DEBUG] mcs_objectstorage_object %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error retrieving mcs_objectstorage_object %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved mcs_objectstorage_object %s: %#v", d.Id(), object)
+
+	// Metadata keys are case insensitive, HTTP headers they are sent in are returned canonicalized.
+	metadata := make(map[string]string, len(object.Metadata))
+	for k, v := range object.Metadata {
+		metadata[strings.ToLower(k)] = aws.StringValue(v)
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("key", key)
+	d.Set("content_type", aws.StringValue(object.ContentType))
+	d.Set("etag", strings.Trim(aws.StringValue(object.ETag), `"`))
+	d.Set("metadata", metadata)
+	d.Set("version_id", aws.StringValue(object.VersionId))
+
+	return nil
+}
+
+func resourceObjectStorageObjectDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(configer)
+	client, err := config.ObjectStorageClient()
+	if err != nil {
+		return fmt.Errorf("error creating object storage client: %s", err)
+	}
+
+	bucket, key, err := parseObjectStorageObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil && !isObjectStorageNotFound(err) {
+		return fmt.Errorf("error deleting mcs_objectstorage_object %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,162 @@
+package mcs
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// testObjectStorageConfig returns dummyConfig with object storage client of the test HTTP server.
+func testObjectStorageConfig(t *testing.T) *dummyConfig {
+	client, err := newObjectStorageClient(objectStorageConfig{
+		Endpoint:       th.Endpoint(),
+		Region:         defaultObjectStorageRegion,
+		AccessKey:      "access",
+		SecretKey:      "secret",
+		ForcePathStyle: true,
+	}, nil)
+	assert.NoError(t, err)
+
+	config := &dummyConfig{}
+	config.On("ObjectStorageClient").Return(client, nil)
+	return config
+}
+
+func TestExpandObjectStorageConfig(t *testing.T) {
+	os.Setenv("OBJECTSTORAGE_ACCESS_KEY", "env-access")
+	defer os.Unsetenv("OBJECTSTORAGE_ACCESS_KEY")
+
+	cfg := expandObjectStorageConfig(nil)
+	assert.Equal(t, defaultObjectStorageEndpoint, cfg.Endpoint)
+	assert.Equal(t, defaultObjectStorageRegion, cfg.Region)
+	assert.Equal(t, "env-access", cfg.AccessKey)
+
+	cfg = expandObjectStorageConfig([]interface{}{map[string]interface{}{
+		"endpoint":               "http://localhost:9000",
+		"region":                 "",
+		"access_key":             "minio",
+		"secret_key":             "minio123",
+		"force_path_style":       true,
+		"create_ec2_credentials": true,
+	}})
+	assert.Equal(t, objectStorageConfig{
+		Endpoint:             "http://localhost:9000",
+		Region:               defaultObjectStorageRegion,
+		AccessKey:            "minio",
+		SecretKey:            "minio123",
+		ForcePathStyle:       true,
+		CreateEC2Credentials: true,
+	}, cfg)
+}
+
+func TestObjectStorageEC2Credentials(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleGetFixture(t, "/users/user/credentials/OS-EC2", `
+{
+  "credentials": [
+    {"user_id": "user", "tenant_id": "other", "access": "other-access", "secret": "other-secret"},
+    {"user_id": "user", "tenant_id": "project", "access": "access", "secret": "secret"}
+  ]
+}`)
+
+	client := fake.ServiceClient()
+	authResult := tokens.CreateResult{}
+	authResult.Header = http.Header{"X-Subject-Token": []string{fake.TokenID}}
+	authResult.Body = map[string]interface{}{"token": map[string]interface{}{"user": map[string]interface{}{"id": "user"}}}
+	assert.NoError(t, client.ProviderClient.SetTokenAndAuthResult(authResult))
+
+	accessKey, secretKey, err := objectStorageEC2Credentials(client, "project", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "access", accessKey)
+	assert.Equal(t, "secret", secretKey)
+
+	// Credentials are not created implicitly, the request would fail on the GET-only fixture.
+	_, _, err = objectStorageEC2Credentials(client, "new-project", false)
+	assert.EqualError(t, err, "the user has no EC2 credentials in project new-project: set access_key and secret_key "+
+		"of the objectstorage provider block or set create_ec2_credentials to create them")
+}
+
+func TestResourceObjectStorageBucketRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/backups", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "HEAD":
+			w.WriteHeader(http.StatusOK)
+		case r.URL.Query().Has("acl"):
+			fmt.Fprint(w, objectStorageBucketACLFixture)
+		case r.URL.Query().Has("versioning"):
+			fmt.Fprint(w, objectStorageBucketVersioningFixture)
+		case r.URL.Query().Has("lifecycle"):
+			fmt.Fprint(w, objectStorageBucketLifecycleFixture)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	})
+	th.Mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	config := testObjectStorageConfig(t)
+
+	d := schema.TestResourceDataRaw(t, resourceObjectStorageBucket().Schema, map[string]interface{}{})
+	d.SetId("backups")
+
+	assert.NoError(t, resourceObjectStorageBucketRead(d, config))
+	assert.Equal(t, "backups", d.Get("bucket"))
+	assert.Equal(t, "public-read", d.Get("acl"))
+	assert.Equal(t, true, d.Get("versioning"))
+	assert.Equal(t, 1, d.Get("lifecycle_rule.#"))
+	assert.Equal(t, "dumps", d.Get("lifecycle_rule.0.id"))
+	assert.Equal(t, "dumps/", d.Get("lifecycle_rule.0.prefix"))
+	assert.Equal(t, true, d.Get("lifecycle_rule.0.enabled"))
+	assert.Equal(t, 30, d.Get("lifecycle_rule.0.expiration_days"))
+	assert.Equal(t, 7, d.Get("lifecycle_rule.0.noncurrent_version_expiration_days"))
+	assert.Equal(t, 0, d.Get("lifecycle_rule.0.abort_incomplete_multipart_upload_days"))
+
+	d.SetId("missing")
+	assert.NoError(t, resourceObjectStorageBucketRead(d, config))
+	assert.Empty(t, d.Id())
+}
+
+func TestResourceObjectStorageObjectRead(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/backups/dumps/db.sql", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+
+		w.Header().Set("Content-Type", "application/sql")
+		w.Header().Set("ETag", `"9e107d9d372bb6826bd81d3542a419d6"`)
+		w.Header().Set("X-Amz-Meta-Database", "orders")
+		w.Header().Set("X-Amz-Version-Id", "v1")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	config := testObjectStorageConfig(t)
+
+	d := schema.TestResourceDataRaw(t, resourceObjectStorageObject().Schema, map[string]interface{}{})
+	d.SetId("backups/dumps/db.sql")
+
+	assert.NoError(t, resourceObjectStorageObjectRead(d, config))
+	assert.Equal(t, "backups", d.Get("bucket"))
+	assert.Equal(t, "dumps/db.sql", d.Get("key"))
+	assert.Equal(t, "application/sql", d.Get("content_type"))
+	assert.Equal(t, "9e107d9d372bb6826bd81d3542a419d6", d.Get("etag"))
+	assert.Equal(t, map[string]interface{}{"database": "orders"}, d.Get("metadata"))
+	assert.Equal(t, "v1", d.Get("version_id"))
+
+	d.SetId("backups/missing.sql")
+	assert.NoError(t, resourceObjectStorageObjectRead(d, config))
+	assert.Empty(t, d.Id())
+}
@@ -0,0 +1,95 @@
+package mcs
+
+import (
+	"net/http"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func tagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+type tagsResult struct {
+	gophercloud.ErrResult
+}
+
+// tagsUpdate replaces all tags of the resource with the given ones.
+// Container infra service keeps them under "tags", database service under "metadata".
+func tagsUpdate(client ContainerClient, url, key string, tags map[string]string) (r tagsResult) {
+	b := map[string]interface{}{key: tags}
+	var result *http.Response
+	reqOpts := getRequestOpts(200, 202, 204)
+	result, r.Err = client.Put(url, b, nil, reqOpts)
+	if r.Err == nil {
+		r.Header = result.Header
+	}
+	return
+}
+
+func expandDefaultTags(v []interface{}) map[string]string {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	return expandTags(v[0].(map[string]interface{})["tags"].(map[string]interface{}))
+}
+
+func expandTags(v map[string]interface{}) map[string]string {
+	tags := make(map[string]string, len(v))
+	for key, value := range v {
+		tags[key] = value.(string)
+	}
+	return tags
+}
+
+// mergeTags returns default tags of the provider overridden by tags of the resource.
+func mergeTags(defaultTags map[string]string, tags map[string]interface{}) map[string]string {
+	tagsAll := make(map[string]string, len(defaultTags)+len(tags))
+	for key, value := range defaultTags {
+		tagsAll[key] = value
+	}
+	for key, value := range tags {
+		tagsAll[key] = value.(string)
+	}
+	return tagsAll
+}
+
+// flattenTags returns tags of the resource omitting the ones inherited from default tags of the provider.
+func flattenTags(defaultTags map[string]string, tags map[string]interface{}, tagsAll map[string]string) map[string]string {
+	m := make(map[string]string)
+	for key, value := range tagsAll {
+		_, configured := tags[key]
+		if defaultValue, ok := defaultTags[key]; ok && defaultValue == value && !configured {
+			continue
+		}
+		m[key] = value
+	}
+	return m
+}
+
+// customizeDiffTagsAll plans tags_all as a merge of the provider default tags and tags of the resource.
+func customizeDiffTagsAll(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+	config := meta.(configer)
+	return d.SetNew("tags_all", mergeTags(config.GetDefaultTags(), d.Get("tags").(map[string]interface{})))
+}
+
+func readTags(d *schema.ResourceData, config configer, tagsAll map[string]string) {
+	d.Set("tags", flattenTags(config.GetDefaultTags(), d.Get("tags").(map[string]interface{}), tagsAll))
+	d.Set("tags_all", tagsAll)
+}
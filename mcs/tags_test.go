@@ -0,0 +1,60 @@
+package mcs
+
+import (
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeTags(t *testing.T) {
+	defaultTags := map[string]string{"team": "infra", "env": "prod"}
+	tags := map[string]interface{}{"team": "backend", "service": "billing"}
+
+	expected := map[string]string{"team": "backend", "env": "prod", "service": "billing"}
+	assert.Equal(t, expected, mergeTags(defaultTags, tags))
+	assert.Equal(t, map[string]string{}, mergeTags(nil, nil))
+}
+
+func TestFlattenTags(t *testing.T) {
+	defaultTags := map[string]string{"team": "infra", "env": "prod"}
+	tagsAll := map[string]string{"team": "infra", "env": "stage", "service": "billing"}
+
+	// Inherited tag is omitted, overridden and own tags are kept.
+	expected := map[string]string{"env": "stage", "service": "billing"}
+	assert.Equal(t, expected, flattenTags(defaultTags, nil, tagsAll))
+
+	// Tag set explicitly to the default value is kept.
+	expected = map[string]string{"team": "infra", "env": "stage", "service": "billing"}
+	assert.Equal(t, expected, flattenTags(defaultTags, map[string]interface{}{"team": "infra"}, tagsAll))
+}
+
+func TestExpandDefaultTags(t *testing.T) {
+	assert.Nil(t, expandDefaultTags(nil))
+
+	raw := []interface{}{
+		map[string]interface{}{
+			"tags": map[string]interface{}{"team": "infra"},
+		},
+	}
+	assert.Equal(t, map[string]string{"team": "infra"}, expandDefaultTags(raw))
+}
+
+func TestTagsUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance/metadata", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"metadata": {"team": "backend"}}`)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	serviceClient := fake.ServiceClient()
+	url := metadataURL(serviceClient, instancesAPIPath, "instance")
+	assert.NoError(t, tagsUpdate(serviceClient, url, "metadata", map[string]string{"team": "backend"}).ExtractErr())
+}
@@ -2,9 +2,11 @@ package mcs
 
 import (
 	"encoding/json"
+	"io/ioutil"
 	"net/http"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/gophercloud/gophercloud"
 	"github.com/stretchr/testify/mock"
 )
@@ -51,12 +53,96 @@ func (d *dummyConfig) DatabaseV1Client(region string) (ContainerClient, error) {
 	return nil, args.Error(0)
 }
 
+// ImageV2Client returns dummy ImageV2Client
+func (d *dummyConfig) ImageV2Client(region string) (*gophercloud.ServiceClient, error) {
+	args := d.Called(region)
+	if r, ok := args.Get(0).(*gophercloud.ServiceClient); ok {
+		return r, args.Error(1)
+	}
+	return nil, args.Error(0)
+}
+
+// NetworkingV2Client returns dummy NetworkingV2Client
+func (d *dummyConfig) NetworkingV2Client(region string) (*gophercloud.ServiceClient, error) {
+	args := d.Called(region)
+	if r, ok := args.Get(0).(*gophercloud.ServiceClient); ok {
+		return r, args.Error(1)
+	}
+	return nil, args.Error(0)
+}
+
+// LoadBalancerV2Client returns dummy LoadBalancerV2Client
+func (d *dummyConfig) LoadBalancerV2Client(region string) (*gophercloud.ServiceClient, error) {
+	args := d.Called(region)
+	if r, ok := args.Get(0).(*gophercloud.ServiceClient); ok {
+		return r, args.Error(1)
+	}
+	return nil, args.Error(0)
+}
+
+// ComputeV2Client returns dummy ComputeV2Client
+func (d *dummyConfig) ComputeV2Client(region string) (*gophercloud.ServiceClient, error) {
+	args := d.Called(region)
+	if r, ok := args.Get(0).(*gophercloud.ServiceClient); ok {
+		return r, args.Error(1)
+	}
+	return nil, args.Error(0)
+}
+
+// BlockStorageV3Client returns dummy BlockStorageV3Client
+func (d *dummyConfig) BlockStorageV3Client(region string) (*gophercloud.ServiceClient, error) {
+	args := d.Called(region)
+	if r, ok := args.Get(0).(*gophercloud.ServiceClient); ok {
+		return r, args.Error(1)
+	}
+	return nil, args.Error(0)
+}
+
+// DNSV2Client returns dummy DNSV2Client
+func (d *dummyConfig) DNSV2Client(region string) (*gophercloud.ServiceClient, error) {
+	args := d.Called(region)
+	if r, ok := args.Get(0).(*gophercloud.ServiceClient); ok {
+		return r, args.Error(1)
+	}
+	return nil, args.Error(0)
+}
+
+// ObjectStorageClient returns dummy ObjectStorageClient
+func (d *dummyConfig) ObjectStorageClient() (s3iface.S3API, error) {
+	args := d.Called()
+	if r, ok := args.Get(0).(s3iface.S3API); ok {
+		return r, args.Error(1)
+	}
+	return nil, args.Error(0)
+}
+
 // GetRegion is a dummy method to return region.
 func (d *dummyConfig) GetRegion() string {
 	args := d.Called()
 	return args.String(0)
 }
 
+// GetDefaultTags is a dummy method to return default tags.
+func (d *dummyConfig) GetDefaultTags() map[string]string {
+	args := d.Called()
+	if r, ok := args.Get(0).(map[string]string); ok {
+		return r
+	}
+	return nil
+}
+
+// GetProjectID is a dummy method to return project ID.
+func (d *dummyConfig) GetProjectID() string {
+	args := d.Called()
+	return args.String(0)
+}
+
+// GetQuotaPreflight is a dummy method to return quotas check mode.
+func (d *dummyConfig) GetQuotaPreflight() string {
+	args := d.Called()
+	return args.String(0)
+}
+
 // ContainerClientFixture ...
 type ContainerClientFixture struct {
 	mock.Mock
@@ -194,3 +280,399 @@ func makeClusterDeleteResponseFixture() *http.Response {
 		StatusCode: 202,
 	}
 }
+
+func makeKubeConfigResponseFixture(kubeConfig string) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    200,
+		Body:          ioutil.NopCloser(strings.NewReader(kubeConfig)),
+		ContentLength: int64(len(kubeConfig)),
+	}
+}
+
+const networkGetFixture = `{
+	"network": {
+		"id": "network",
+		"name": "private",
+		"description": "network for k8s",
+		"status": "ACTIVE",
+		"admin_state_up": true,
+		"port_security_enabled": true,
+		"router:external": false,
+		"subnets": ["subnet"]
+	}
+}`
+
+const subnetGetFixture = `{
+	"subnet": {
+		"id": "subnet",
+		"network_id": "network",
+		"name": "private-subnet",
+		"description": "subnet for k8s",
+		"cidr": "192.168.199.0/24",
+		"ip_version": 4,
+		"gateway_ip": "192.168.199.1",
+		"enable_dhcp": true,
+		"dns_nameservers": ["8.8.8.8", "8.8.4.4"],
+		"allocation_pools": [{"start": "192.168.199.10", "end": "192.168.199.200"}]
+	}
+}`
+
+const routerGetFixture = `{
+	"router": {
+		"id": "router",
+		"name": "router",
+		"description": "router for k8s",
+		"status": "ACTIVE",
+		"admin_state_up": true,
+		"external_gateway_info": {
+			"network_id": "ext-net",
+			"external_fixed_ips": [{"subnet_id": "ext-subnet", "ip_address": "89.208.84.10"}]
+		}
+	}
+}`
+
+const routerInterfacePortGetFixture = `{
+	"port": {
+		"id": "port",
+		"network_id": "network",
+		"device_id": "router",
+		"device_owner": "network:router_interface",
+		"status": "ACTIVE",
+		"fixed_ips": [{"subnet_id": "subnet", "ip_address": "192.168.199.1"}]
+	}
+}`
+
+const secGroupGetFixture = `{
+	"security_group": {
+		"id": "secgroup",
+		"name": "db",
+		"description": "access to databases",
+		"security_group_rules": [
+			{"id": "egress-ipv4", "direction": "egress", "ethertype": "IPv4", "security_group_id": "secgroup"},
+			{"id": "egress-ipv6", "direction": "egress", "ethertype": "IPv6", "security_group_id": "secgroup"}
+		]
+	}
+}`
+
+const secGroupRuleGetFixture = `{
+	"security_group_rule": {
+		"id": "rule",
+		"security_group_id": "secgroup",
+		"direction": "ingress",
+		"ethertype": "IPv4",
+		"protocol": "tcp",
+		"port_range_min": 5432,
+		"port_range_max": 5432,
+		"remote_ip_prefix": "10.0.0.0/24",
+		"description": "postgres"
+	}
+}`
+
+const floatingIPListFixture = `{
+	"floatingips": [
+		{
+			"id": "fip",
+			"floating_network_id": "ext-net",
+			"floating_ip_address": "89.208.84.20",
+			"port_id": "%s",
+			"fixed_ip_address": "",
+			"status": "DOWN"
+		}
+	]
+}`
+
+const lbLoadBalancerGetFixture = `{
+	"loadbalancer": {
+		"id": "lb",
+		"name": "db-lb",
+		"description": "front of db",
+		"vip_subnet_id": "subnet",
+		"vip_address": "192.168.0.10",
+		"vip_port_id": "vip-port",
+		"availability_zone": "MS1",
+		"admin_state_up": true,
+		"provisioning_status": "%s",
+		"operating_status": "ONLINE"
+	}
+}`
+
+const lbPoolGetFixture = `{
+	"pool": {
+		"id": "pool",
+		"name": "db-pool",
+		"protocol": "TCP",
+		"lb_algorithm": "ROUND_ROBIN",
+		"admin_state_up": true,
+		"listeners": [{"id": "listener"}],
+		"loadbalancers": [],
+		"session_persistence": {"type": "SOURCE_IP"},
+		"provisioning_status": "ACTIVE"
+	}
+}`
+
+const lbListenerGetFixture = `{
+	"listener": {
+		"id": "listener",
+		"name": "%s",
+		"protocol": "TCP",
+		"protocol_port": 5432,
+		"loadbalancers": [{"id": "lb"}],
+		"admin_state_up": true,
+		"provisioning_status": "ACTIVE"
+	}
+}`
+
+const lbMemberGetFixture = `{
+	"member": {
+		"id": "member",
+		"address": "192.168.0.20",
+		"protocol_port": 5432,
+		"subnet_id": "subnet",
+		"weight": 10,
+		"admin_state_up": true,
+		"provisioning_status": "ACTIVE"
+	}
+}`
+
+const keypairGetFixture = `{
+	"keypair": {
+		"name": "deployer",
+		"public_key": "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC deployer@example.com",
+		"fingerprint": "ee:7a:88:0d:3b:8c:2f:9b:31:59:0a:2c:0d:05:4f:21",
+		"user_id": "user"
+	}
+}`
+
+const computeInstanceGetFixture = `{
+	"server": {
+		"id": "instance",
+		"name": "bastion",
+		"status": "%s",
+		"image": {"id": "image"},
+		"flavor": {"id": "flavor"},
+		"key_name": "deployer",
+		"accessIPv4": "",
+		"OS-EXT-AZ:availability_zone": "MS1",
+		"security_groups": [{"name": "default"}, {"name": "ssh"}],
+		"fault": {"message": "No valid host was found."}
+	}
+}`
+
+const computeInstancePortListFixture = `{
+	"ports": [
+		{
+			"id": "port",
+			"network_id": "network",
+			"device_id": "instance",
+			"mac_address": "fa:16:3e:00:00:01",
+			"fixed_ips": [{"subnet_id": "subnet", "ip_address": "192.168.0.30"}]
+		}
+	]
+}`
+
+const blockStorageVolumeGetFixture = `{
+	"volume": {
+		"id": "volume",
+		"name": "data",
+		"description": "data volume",
+		"status": "%s",
+		"size": 20,
+		"volume_type": "ceph-ssd",
+		"availability_zone": "MS1",
+		"snapshot_id": "",
+		"source_volid": "",
+		"metadata": {"purpose": "data"},
+		"attachments": []
+	}
+}`
+
+const blockStorageVolumeTypesListFixture = `{
+	"volume_types": [
+		{"id": "type-ssd", "name": "ceph-ssd", "description": "SSD"},
+		{"id": "type-hdd", "name": "ceph-hdd", "description": "HDD"}
+	]
+}`
+
+const computeVolumeAttachGetFixture = `{
+	"volumeAttachment": {
+		"id": "volume",
+		"serverId": "instance",
+		"volumeId": "volume",
+		"device": "/dev/vdb"
+	}
+}`
+
+const computeFlavorListFixture = `{
+	"flavors": [
+		{"id": "flavor-large", "name": "Standard-4-8-80", "vcpus": 4, "ram": 8192, "disk": 80, "os-flavor-access:is_public": true},
+		{"id": "flavor-small", "name": "Basic-1-2-20", "vcpus": 1, "ram": 2048, "disk": 20, "os-flavor-access:is_public": true},
+		{"id": "flavor-medium", "name": "Standard-2-4-40", "vcpus": 2, "ram": 4096, "disk": 40, "os-flavor-access:is_public": true},
+		{"id": "flavor-medium-private", "name": "Private-2-4-40", "vcpus": 2, "ram": 4096, "disk": 40, "os-flavor-access:is_public": false}
+	]
+}`
+
+const imagesListFixture = `{
+	"images": [
+		{
+			"id": "image-old",
+			"name": "ubuntu-20.04",
+			"status": "active",
+			"visibility": "public",
+			"owner": "owner",
+			"tags": ["worker"],
+			"container_format": "bare",
+			"disk_format": "raw",
+			"min_disk": 10,
+			"min_ram": 0,
+			"size": 2147483648,
+			"created_at": "2021-03-01T10:00:00Z",
+			"updated_at": "2021-03-01T10:05:00Z",
+			"os_distro": "ubuntu",
+			"hw_qemu_guest_agent": "yes"
+		},
+		{
+			"id": "image-new",
+			"name": "ubuntu-20.04",
+			"status": "active",
+			"visibility": "public",
+			"owner": "owner",
+			"tags": ["worker"],
+			"container_format": "bare",
+			"disk_format": "raw",
+			"min_disk": 10,
+			"min_ram": 0,
+			"size": 2147483648,
+			"created_at": "2021-06-01T10:00:00Z",
+			"updated_at": "2021-06-01T10:05:00Z",
+			"os_distro": "ubuntu"
+		}
+	]
+}`
+
+const dnsZoneGetFixture = `{
+	"id": "zone",
+	"name": "example.internal.",
+	"email": "admin@example.internal",
+	"description": "internal zone",
+	"ttl": 3600,
+	"serial": 1,
+	"status": "ACTIVE",
+	"type": "PRIMARY"
+}`
+
+const dnsRecordSetGetFixture = `{
+	"id": "recordset",
+	"zone_id": "zone",
+	"zone_name": "example.internal.",
+	"name": "db.example.internal.",
+	"type": "A",
+	"records": ["10.0.0.10", "10.0.0.11"],
+	"ttl": 300,
+	"status": "ACTIVE",
+	"description": "database"
+}`
+
+const objectStorageBucketACLFixture = `<?xml version="1.0" encoding="UTF-8"?>
+<AccessControlPolicy xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+	<Owner><ID>owner</ID></Owner>
+	<AccessControlList>
+		<Grant>
+			<Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>owner</ID></Grantee>
+			<Permission>FULL_CONTROL</Permission>
+		</Grant>
+		<Grant>
+			<Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="Group"><URI>http://acs.amazonaws.com/groups/global/AllUsers</URI></Grantee>
+			<Permission>READ</Permission>
+		</Grant>
+	</AccessControlList>
+</AccessControlPolicy>`
+
+const objectStorageBucketVersioningFixture = `<?xml version="1.0" encoding="UTF-8"?>
+<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+	<Status>Enabled</Status>
+</VersioningConfiguration>`
+
+const objectStorageBucketLifecycleFixture = `<?xml version="1.0" encoding="UTF-8"?>
+<LifecycleConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+	<Rule>
+		<ID>dumps</ID>
+		<Filter><Prefix>dumps/</Prefix></Filter>
+		<Status>Enabled</Status>
+		<Expiration><Days>30</Days></Expiration>
+		<NoncurrentVersionExpiration><NoncurrentDays>7</NoncurrentDays></NoncurrentVersionExpiration>
+	</Rule>
+</LifecycleConfiguration>`
+
+const computeLimitsFixture = `{
+	"limits": {
+		"rate": [],
+		"absolute": {
+			"maxTotalCores": 20,
+			"maxTotalRAMSize": 40960,
+			"maxTotalInstances": 10,
+			"totalCoresUsed": 19,
+			"totalRAMUsed": 16384,
+			"totalInstancesUsed": 4
+		}
+	}
+}`
+
+const blockStorageQuotaUsageFixture = `{
+	"quota_set": {
+		"id": "project",
+		"volumes": {"in_use": 5, "reserved": 1, "limit": 10},
+		"gigabytes": {"in_use": 480, "reserved": 0, "limit": 500},
+		"snapshots": {"in_use": 0, "reserved": 0, "limit": -1}
+	}
+}`
+
+const networkingQuotaDetailFixture = `{
+	"quota": {
+		"floatingip": {"used": 2, "reserved": 1, "limit": 3},
+		"network": {"used": 1, "reserved": 0, "limit": 10},
+		"subnet": {"used": 1, "reserved": 0, "limit": 10},
+		"router": {"used": 1, "reserved": 0, "limit": 5},
+		"port": {"used": 12, "reserved": 0, "limit": 100},
+		"security_group": {"used": 3, "reserved": 0, "limit": 20}
+	}
+}`
+
+const databaseLimitsFixture = `{
+	"limits": [
+		{"verb": "ABSOLUTE", "max_instances": 5, "max_volumes": 100, "max_backups": 50},
+		{"verb": "POST", "value": 200, "remaining": 200, "unit": "MINUTE"}
+	]
+}`
+
+const databaseInstanceListFixture = `{
+	"instances": [
+		{"id": "instance-1", "name": "db-1", "status": "ACTIVE", "volume": {"size": 20}},
+		{"id": "instance-2", "name": "db-2", "status": "ACTIVE", "volume": {"size": 40}}
+	]
+}`
+
+const blockStorageSnapshotGetFixture = `{
+	"snapshot": {
+		"id": "snapshot",
+		"volume_id": "volume",
+		"name": "%s",
+		"description": "before upgrade",
+		"status": "available",
+		"size": 10
+	}
+}`
+
+const lbMonitorGetFixture = `{
+	"healthmonitor": {
+		"id": "monitor",
+		"name": "%s",
+		"type": "TCP",
+		"delay": 10,
+		"timeout": 5,
+		"max_retries": 3,
+		"max_retries_down": 3,
+		"pools": [{"id": "pool"}],
+		"admin_state_up": true
+	}
+}`
@@ -28,6 +28,18 @@ func scaleURL(c ContainerClient, api string, id string) string {
 	return c.ServiceURL(api, id, "actions", "scale")
 }
 
+func addonsURL(c ContainerClient, api string, id string) string {
+	return c.ServiceURL(api, id, addonsAPIPath)
+}
+
+func addonURL(c ContainerClient, api string, id string, addonID string) string {
+	return c.ServiceURL(api, id, addonsAPIPath, addonID)
+}
+
+func availableAddonsURL(c ContainerClient, api string, id string) string {
+	return c.ServiceURL(api, id, availableAddonsAPIPath)
+}
+
 func instanceActionURL(c ContainerClient, api string, id string) string {
 	return c.ServiceURL(api, id, "action")
 }
@@ -63,3 +75,11 @@ func instanceUserURL(c ContainerClient, api string, id string, userName string)
 func instanceDatabaseURL(c ContainerClient, api string, id string, databaseName string) string {
 	return c.ServiceURL(api, id, "databases", databaseName)
 }
+
+func tagsURL(c ContainerClient, api string, id string) string {
+	return c.ServiceURL(api, id, "tags")
+}
+
+func metadataURL(c ContainerClient, api string, id string) string {
+	return c.ServiceURL(api, id, "metadata")
+}
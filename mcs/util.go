@@ -2,11 +2,13 @@ package mcs
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/mitchellh/mapstructure"
+	"gopkg.in/yaml.v2"
 )
 
 var decoderConfig = &mapstructure.DecoderConfig{
@@ -39,10 +41,16 @@ func checkDeleted(d *schema.ResourceData, err error, msg string) error {
 	return fmt.Errorf("%s %s: %s", msg, d.Id(), err)
 }
 
+// resourceValueGetter is implemented by schema.ResourceData and schema.ResourceDiff,
+// so that resource values are read the same way on apply and on plan.
+type resourceValueGetter interface {
+	GetOk(key string) (interface{}, bool)
+}
+
 // getRegion returns the region that was specified in the resource. If a
 // region was not set, the provider-level region is checked. The provider-level
 // region can either be set by the region argument or by OS_REGION_NAME.
-func getRegion(d *schema.ResourceData, config configer) string {
+func getRegion(d resourceValueGetter, config configer) string {
 	if v, ok := d.GetOk("region"); ok {
 		return v.(string)
 	}
@@ -72,3 +80,34 @@ func ensureOnlyOnePresented(d *schema.ResourceData, keys ...string) (string, err
 
 	return keyPresented, nil
 }
+
+// validateYAML checks that value is a valid YAML document.
+func validateYAML(val interface{}, key string) (warns []string, errs []error) {
+	var v interface{}
+	if err := yaml.Unmarshal([]byte(val.(string)), &v); err != nil {
+		errs = append(errs, fmt.Errorf("%q contains an invalid YAML: %s", key, err))
+	}
+	return
+}
+
+// suppressEquivalentYAMLDiffs suppresses diff of YAML documents differing only in formatting.
+func suppressEquivalentYAMLDiffs(k, old, new string, d *schema.ResourceData) bool {
+	var oldValue, newValue interface{}
+	if err := yaml.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+	if err := yaml.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldValue, newValue)
+}
+
+func expandToMapStringString(v map[string]interface{}) map[string]string {
+	m := make(map[string]string, len(v))
+	for key, value := range v {
+		if str, ok := value.(string); ok {
+			m[key] = str
+		}
+	}
+	return m
+}